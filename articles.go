@@ -0,0 +1,183 @@
+package golexoffice
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+)
+
+// ArticleType is the type of an article
+type ArticleType string
+
+const (
+	ArticleTypeProduct ArticleType = "PRODUCT"
+	ArticleTypeService ArticleType = "SERVICE"
+)
+
+// ArticleBody is to define body data
+type ArticleBody struct {
+	Id             string           `json:"id,omitempty"`
+	OrganizationId string           `json:"organizationId,omitempty"`
+	CreatedDate    string           `json:"createdDate,omitempty"`
+	UpdatedDate    string           `json:"updatedDate,omitempty"`
+	Archived       bool             `json:"archived,omitempty"`
+	Title          string           `json:"title"`
+	Description    string           `json:"description,omitempty"`
+	Type           ArticleType      `json:"type"`
+	ArticleNumber  string           `json:"articleNumber,omitempty"`
+	Gtin           string           `json:"gtin,omitempty"`
+	Note           string           `json:"note,omitempty"`
+	UnitName       string           `json:"unitName"`
+	Price          ArticleBodyPrice `json:"price"`
+	Version        int              `json:"version"`
+}
+
+type ArticleBodyPrice struct {
+	NetPrice     float64 `json:"netPrice,omitempty"`
+	GrossPrice   float64 `json:"grossPrice,omitempty"`
+	LeadingPrice string  `json:"leadingPrice"`
+	TaxRate      float64 `json:"taxRate"`
+}
+
+// ArticleReturn is to decode json data
+type ArticleReturn struct {
+	Id          string `json:"id"`
+	ResourceUri string `json:"resourceUri"`
+	CreatedDate string `json:"createdDate"`
+	UpdatedDate string `json:"updatedDate"`
+	Version     int    `json:"version"`
+}
+
+// ArticleFilter is to filter the articles, empty values are not sent
+type ArticleFilter struct {
+	ArticleNumber string
+	Gtin          string
+	Type          ArticleType
+}
+
+// Articles is to iterate over all articles matching the filter
+func (c *Config) Articles(filter ArticleFilter) *Pager[ArticleBody] {
+	query := url.Values{}
+	if filter.ArticleNumber != "" {
+		query.Set("articleNumber", filter.ArticleNumber)
+	}
+	if filter.Gtin != "" {
+		query.Set("gtin", filter.Gtin)
+	}
+	if filter.Type != "" {
+		query.Set("type", string(filter.Type))
+	}
+
+	return newPager[ArticleBody](c, "/v1/articles", query)
+}
+
+// Article is to get an article by id
+func (c *Config) Article(id string) (ArticleBody, error) {
+
+	// Send request
+	response, err := c.Send("/v1/articles/"+id, nil, "GET", "application/json")
+	if err != nil {
+		return ArticleBody{}, err
+	}
+
+	// Close request
+	defer response.Body.Close()
+
+	// Decode data
+	var decode ArticleBody
+
+	err = json.NewDecoder(response.Body).Decode(&decode)
+	if err != nil {
+		return ArticleBody{}, err
+	}
+
+	// Return data
+	return decode, nil
+
+}
+
+// AddArticle is to create an article
+func (c *Config) AddArticle(body ArticleBody) (ArticleReturn, error) {
+	return c.sendArticle("/v1/articles", "POST", body)
+}
+
+// UpdateArticle is to update an article, the body needs the id & the version
+//
+// When the article was changed in the meantime, the error wraps
+// ErrVersionConflict. Get the article again and retry.
+func (c *Config) UpdateArticle(body ArticleBody) (ArticleReturn, error) {
+	return c.sendArticle("/v1/articles/"+body.Id, "PUT", body)
+}
+
+// DeleteArticle is to delete an article by id
+func (c *Config) DeleteArticle(id string) error {
+
+	// Send request
+	response, err := c.Send("/v1/articles/"+id, nil, "DELETE", "application/json")
+	if err != nil {
+		return err
+	}
+
+	// Close request
+	return response.Body.Close()
+
+}
+
+func (c *Config) sendArticle(path, method string, body ArticleBody) (ArticleReturn, error) {
+
+	// Convert body
+	convert, err := json.Marshal(body)
+	if err != nil {
+		return ArticleReturn{}, err
+	}
+
+	// Send request
+	response, err := c.Send(path, bytes.NewBuffer(convert), method, "application/json")
+	if err != nil {
+		return ArticleReturn{}, err
+	}
+
+	// Close request
+	defer response.Body.Close()
+
+	// Decode data
+	var decode ArticleReturn
+
+	err = json.NewDecoder(response.Body).Decode(&decode)
+	if err != nil {
+		return ArticleReturn{}, err
+	}
+
+	// Return data
+	return decode, nil
+
+}
+
+// LineItem is to use the article as line item of an invoice (or any other
+// sales voucher), prices of articles are always in EUR
+func (a ArticleBody) LineItem(quantity float64) InvoiceBodyLineItems {
+	lineItemType := "material"
+	if a.Type == ArticleTypeService {
+		lineItemType = "service"
+	}
+
+	unitPrice := InvoiceBodyUnitPrice{
+		Currency:          "EUR",
+		TaxRatePercentage: int(a.Price.TaxRate),
+	}
+	if a.Price.LeadingPrice == "GROSS" {
+		unitPrice.GrossAmount = a.Price.GrossPrice
+	} else {
+		unitPrice.NetAmount = a.Price.NetPrice
+	}
+
+	return InvoiceBodyLineItems{
+		Id:          a.Id,
+		Type:        lineItemType,
+		Name:        a.Title,
+		Description: a.Description,
+		Quantity:    quantity,
+		UnitName:    a.UnitName,
+		UnitPrice:   unitPrice,
+	}
+}
@@ -0,0 +1,109 @@
+package golexoffice_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hostwithquantum/golexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArticles(t *testing.T) {
+	server := articlesMock()
+	defer server.Close()
+
+	lexOffice := golexoffice.NewConfig("token", nil)
+	lexOffice.SetBaseUrl(server.URL)
+
+	t.Run("list", func(t *testing.T) {
+		articles, err := lexOffice.Articles(golexoffice.ArticleFilter{Type: golexoffice.ArticleTypeService}).All()
+		assert.NoError(t, err)
+		assert.Len(t, articles, 1)
+		assert.Equal(t, "Hosting", articles[0].Title)
+	})
+
+	t.Run("get and line item", func(t *testing.T) {
+		article, err := lexOffice.Article("eb46d328-e1dd-11eb-8a6e-3f2a7b8c1d2e")
+		assert.NoError(t, err)
+
+		lineItem := article.LineItem(2)
+		assert.Equal(t, "eb46d328-e1dd-11eb-8a6e-3f2a7b8c1d2e", lineItem.Id)
+		assert.Equal(t, "service", lineItem.Type)
+		assert.Equal(t, 2.0, lineItem.Quantity)
+		assert.Equal(t, 49.0, lineItem.UnitPrice.NetAmount)
+		assert.Nil(t, lineItem.UnitPrice.GrossAmount)
+		assert.Equal(t, 19, lineItem.UnitPrice.TaxRatePercentage)
+	})
+
+	t.Run("update=conflict", func(t *testing.T) {
+		_, err := lexOffice.UpdateArticle(golexoffice.ArticleBody{
+			Id:      "eb46d328-e1dd-11eb-8a6e-3f2a7b8c1d2e",
+			Title:   "Hosting",
+			Version: 1,
+		})
+		assert.Error(t, err)
+		assert.True(t, errors.Is(err, golexoffice.ErrVersionConflict))
+	})
+
+	t.Run("update", func(t *testing.T) {
+		resp, err := lexOffice.UpdateArticle(golexoffice.ArticleBody{
+			Id:      "eb46d328-e1dd-11eb-8a6e-3f2a7b8c1d2e",
+			Title:   "Hosting",
+			Version: 2,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, resp.Version)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		assert.NoError(t, lexOffice.DeleteArticle("eb46d328-e1dd-11eb-8a6e-3f2a7b8c1d2e"))
+	})
+}
+
+func articlesMock() *httptest.Server {
+	article := `{
+		"id": "eb46d328-e1dd-11eb-8a6e-3f2a7b8c1d2e",
+		"title": "Hosting",
+		"type": "SERVICE",
+		"articleNumber": "HOST-1",
+		"unitName": "Monat",
+		"price": {"netPrice": 49.00, "grossPrice": 58.31, "leadingPrice": "NET", "taxRate": 19},
+		"version": 2
+	}`
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/articles" && r.URL.Query().Get("type") == "SERVICE":
+			w.WriteHeader(http.StatusOK)
+			//nolint:errcheck
+			w.Write([]byte(`{"content": [` + article + `], "first": true, "last": true, "totalPages": 1, "totalElements": 1, "numberOfElements": 1, "size": 25, "number": 0}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/articles/eb46d328-e1dd-11eb-8a6e-3f2a7b8c1d2e":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(article)) //nolint:errcheck
+		case r.Method == http.MethodPut && r.URL.Path == "/v1/articles/eb46d328-e1dd-11eb-8a6e-3f2a7b8c1d2e":
+			var body golexoffice.ArticleBody
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Version != 2 {
+				w.WriteHeader(http.StatusConflict)
+				//nolint:errcheck
+				w.Write([]byte(`{"status": 409, "error": "Conflict", "message": "Version mismatch."}`))
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "eb46d328-e1dd-11eb-8a6e-3f2a7b8c1d2e",
+				"resourceUri": "https://api.lexoffice.io/v1/articles/eb46d328-e1dd-11eb-8a6e-3f2a7b8c1d2e",
+				"version": 3
+			}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/v1/articles/eb46d328-e1dd-11eb-8a6e-3f2a7b8c1d2e":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
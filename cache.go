@@ -0,0 +1,33 @@
+package golexoffice
+
+import (
+	"sync"
+	"time"
+)
+
+// cache is to keep a value in memory for a while
+type cache[T any] struct {
+	mu      sync.Mutex
+	value   T
+	expires time.Time
+}
+
+// get is to return the cached value, or load it when it is expired
+func (c *cache[T]) get(ttl time.Duration, load func() (T, error)) (T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if time.Now().Before(c.expires) {
+		return c.value, nil
+	}
+
+	value, err := load()
+	if err != nil {
+		return value, err
+	}
+
+	c.value = value
+	c.expires = time.Now().Add(ttl)
+
+	return value, nil
+}
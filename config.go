@@ -10,25 +10,32 @@
 package golexoffice
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
-	"strings"
 	"time"
 )
 
 const (
 	baseURL           = "https://api.lexoffice.io"
 	maxRateLimitTries = 3
+	defaultCacheTTL   = 24 * time.Hour
 )
 
 // Config is to define the request data
 type Config struct {
-	token   string
-	baseUrl string
-	client  *http.Client
+	token    string
+	baseUrl  string
+	appUrl   string
+	client   *http.Client
+	cacheTTL time.Duration
+	limiter  rateLimiter
+
+	countries         cache[[]CountryReturn]
+	postingCategories cache[[]PostingCategoryReturn]
 }
 
 func NewConfig(token string, httpClient *http.Client) *Config {
@@ -37,8 +44,9 @@ func NewConfig(token string, httpClient *http.Client) *Config {
 	}
 
 	return &Config{
-		token:  token,
-		client: httpClient,
+		token:    token,
+		client:   httpClient,
+		cacheTTL: defaultCacheTTL,
 	}
 }
 
@@ -46,8 +54,34 @@ func (c *Config) SetBaseUrl(url string) {
 	c.baseUrl = url
 }
 
+// SetAppUrl is to set the url of the lexoffice UI for deeplinks, by default
+// it is derived from the base url
+func (c *Config) SetAppUrl(url string) {
+	c.appUrl = url
+}
+
+// SetCacheTTL is to set how long rarely changing data (e.g. countries) is
+// cached, zero disables the cache
+func (c *Config) SetCacheTTL(ttl time.Duration) {
+	c.cacheTTL = ttl
+}
+
+// SetRequestInterval is to set the minimum interval between two requests,
+// so all requests of the token stay within the rate limit of lexoffice (e.g.
+// 500ms for 2 requests per second), zero disables it (default)
+//
+// NewEventResolver enables the interval, unless it was set before.
+func (c *Config) SetRequestInterval(interval time.Duration) {
+	c.limiter.set(interval)
+}
+
 // Send is to send a new request
 func (c *Config) Send(path string, body io.Reader, method, contentType string) (*http.Response, error) {
+	return c.send(path, body, method, contentType, "application/json")
+}
+
+// send is to send a new request and negotiate the response type via accept
+func (c *Config) send(path string, body io.Reader, method, contentType, accept string) (*http.Response, error) {
 
 	// Set url
 	var url string
@@ -66,11 +100,16 @@ func (c *Config) Send(path string, body io.Reader, method, contentType string) (
 	// Define header
 	request.Header.Set("Authorization", "Bearer "+c.token)
 	request.Header.Set("Content-Type", contentType)
-	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Accept", accept)
 
 	var response *http.Response
 	// Send request & get response
 	for i := 1; ; i++ {
+		err = c.limiter.wait(request.Context())
+		if err != nil {
+			return nil, err
+		}
+
 		response, err = c.client.Do(request)
 		if err != nil {
 			return nil, err
@@ -80,22 +119,33 @@ func (c *Config) Send(path string, body io.Reader, method, contentType string) (
 			// Return data
 			return response, nil
 		} else if hitRateLimit(response) {
-			if i > maxRateLimitTries {
+			// streamed bodies (e.g. file uploads) cannot be sent again
+			if i > maxRateLimitTries || (request.Body != nil && request.GetBody == nil) {
 				break
 			}
 			// max 2 requests per second, so let's wait a bit and try again
+			response.Body.Close()
 			time.Sleep(500 * time.Duration(i) * time.Millisecond)
+
+			if request.GetBody != nil {
+				request.Body, err = request.GetBody()
+				if err != nil {
+					return nil, err
+				}
+			}
 		} else {
 			break
 		}
 	}
 
-	// TODO(till): revisit parsing when we add more API endpoints
-	if strings.Contains(url, "invoices") {
-		return nil, parseErrorResponse(response)
+	// updates (PUT) are locked by the version, other requests conflict for
+	// other reasons (e.g. a duplicate event subscription)
+	err = parseAnyErrorResponse(response)
+	if response.StatusCode == http.StatusConflict && method == http.MethodPut {
+		return nil, errors.Join(ErrVersionConflict, err)
 	}
 
-	return nil, parseLegacyErrorResponse(response)
+	return nil, err
 }
 
 func isSuccessful(response *http.Response) bool {
@@ -106,6 +156,26 @@ func hitRateLimit(response *http.Response) bool {
 	return response.StatusCode == 429
 }
 
+// parseAnyErrorResponse is to pick the error format by the response body,
+// because lexoffice uses the legacy format on some of its endpoints only
+func parseAnyErrorResponse(response *http.Response) error {
+	body, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return fmt.Errorf("reading error while unpacking response: %s", err)
+	}
+	response.Body = io.NopCloser(bytes.NewReader(body))
+
+	var probe struct {
+		IssueList json.RawMessage `json:"IssueList"`
+	}
+	if json.Unmarshal(body, &probe) == nil && probe.IssueList != nil {
+		return parseLegacyErrorResponse(response)
+	}
+
+	return parseErrorResponse(response)
+}
+
 func parseErrorResponse(response *http.Response) error {
 	var errorResp ErrorResponse
 	err := json.NewDecoder(response.Body).Decode(&errorResp)
@@ -39,6 +39,7 @@ type ContactsReturnContent struct {
 	PhoneNumbers   ContactBodyPhoneNumbers   `json:"phoneNumbers"`
 	Note           string                    `json:"note"`
 	Archived       bool                      `json:"archived,omitempty"`
+	XRechnung      *ContactBodyXRechnung     `json:"xRechnung,omitempty"`
 }
 
 type ContactsReturnRoles struct {
@@ -127,6 +128,7 @@ type ContactBody struct {
 	PhoneNumbers   *ContactBodyPhoneNumbers   `json:"phoneNumbers,omitempty"`
 	Note           string                     `json:"note"`
 	Archived       bool                       `json:"archived,omitempty"`
+	XRechnung      *ContactBodyXRechnung      `json:"xRechnung,omitempty"`
 }
 
 type ContactBodyRoles struct {
@@ -150,6 +152,13 @@ type ContactBodyCompany struct {
 	ContactPersons       []*ContactBodyContactPersons `json:"contactPersons"`
 }
 
+// ContactBodyXRechnung is to define the XRechnung data of a customer, the
+// buyer reference of public authorities is their Leitweg-ID
+type ContactBodyXRechnung struct {
+	BuyerReference         string `json:"buyerReference,omitempty"`
+	VendorNumberAtCustomer string `json:"vendorNumberAtCustomer,omitempty"`
+}
+
 type ContactBodyPerson struct {
 	Salutation string `json:"salutation"`
 	FirstName  string `json:"firstName"`
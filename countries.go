@@ -0,0 +1,99 @@
+package golexoffice
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// TaxClassification is the tax classification of a country
+type TaxClassification string
+
+const (
+	TaxClassificationGermany           TaxClassification = "de"
+	TaxClassificationIntraCommunity    TaxClassification = "intraCommunity"
+	TaxClassificationThirdPartyCountry TaxClassification = "thirdPartyCountry"
+)
+
+// CountryReturn is to decode json data
+type CountryReturn struct {
+	CountryCode       string            `json:"countryCode"`
+	CountryNameEN     string            `json:"countryNameEN"`
+	CountryNameDE     string            `json:"countryNameDE"`
+	TaxClassification TaxClassification `json:"taxClassification"`
+}
+
+// Countries is to get a list of all countries, the list is cached (see SetCacheTTL)
+func (c *Config) Countries() ([]CountryReturn, error) {
+	return c.countries.get(c.cacheTTL, func() ([]CountryReturn, error) {
+
+		// Send request
+		response, err := c.Send("/v1/countries", nil, "GET", "application/json")
+		if err != nil {
+			return nil, err
+		}
+
+		// Close request
+		defer response.Body.Close()
+
+		// Decode data
+		var decode []CountryReturn
+
+		err = json.NewDecoder(response.Body).Decode(&decode)
+		if err != nil {
+			return nil, err
+		}
+
+		// Return data
+		return decode, nil
+
+	})
+}
+
+// Country is to get a country by its code (e.g. "DE")
+func (c *Config) Country(countryCode string) (CountryReturn, error) {
+	countries, err := c.Countries()
+	if err != nil {
+		return CountryReturn{}, err
+	}
+
+	for _, country := range countries {
+		if strings.EqualFold(country.CountryCode, countryCode) {
+			return country, nil
+		}
+	}
+
+	return CountryReturn{}, fmt.Errorf("unknown country: %s", countryCode)
+}
+
+// SuggestTaxType is to suggest InvoiceBodyTaxConditions.TaxType for the
+// (first) billing address of a contact
+//
+// Companies with a VAT ID in another EU country get an intra-community supply,
+// contacts outside of the EU a third party country service (or delivery, when
+// service is false). Everything else is "net".
+func (c *Config) SuggestTaxType(contact ContactsReturnContent, service bool) (string, error) {
+	if len(contact.Addresses.Billing) == 0 || contact.Addresses.Billing[0] == nil {
+		return "", errors.New("contact has no billing address")
+	}
+
+	country, err := c.Country(contact.Addresses.Billing[0].CountryCode)
+	if err != nil {
+		return "", err
+	}
+
+	switch country.TaxClassification {
+	case TaxClassificationIntraCommunity:
+		if contact.Company != nil && contact.Company.VatRegistrationId != "" {
+			return "intraCommunitySupply", nil
+		}
+	case TaxClassificationThirdPartyCountry:
+		if service {
+			return "thirdPartyCountryService", nil
+		}
+		return "thirdPartyCountryDelivery", nil
+	}
+
+	return "net", nil
+}
@@ -0,0 +1,90 @@
+package golexoffice_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hostwithquantum/golexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountries(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/countries" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		//nolint:errcheck
+		w.Write([]byte(`[
+			{"countryCode": "DE", "countryNameEN": "Germany", "countryNameDE": "Deutschland", "taxClassification": "de"},
+			{"countryCode": "AT", "countryNameEN": "Austria", "countryNameDE": "Österreich", "taxClassification": "intraCommunity"},
+			{"countryCode": "CH", "countryNameEN": "Switzerland", "countryNameDE": "Schweiz", "taxClassification": "thirdPartyCountry"}
+		]`))
+	}))
+	defer server.Close()
+
+	lexOffice := golexoffice.NewConfig("token", nil)
+	lexOffice.SetBaseUrl(server.URL)
+
+	t.Run("cached", func(t *testing.T) {
+		countries, err := lexOffice.Countries()
+		assert.NoError(t, err)
+		assert.Len(t, countries, 3)
+
+		country, err := lexOffice.Country("at")
+		assert.NoError(t, err)
+		assert.Equal(t, golexoffice.TaxClassificationIntraCommunity, country.TaxClassification)
+		assert.Equal(t, 1, requests)
+
+		_, err = lexOffice.Country("XX")
+		assert.Error(t, err)
+	})
+
+	t.Run("tax type", func(t *testing.T) {
+		contact := func(countryCode, vatId string) golexoffice.ContactsReturnContent {
+			return golexoffice.ContactsReturnContent{
+				Company: &golexoffice.ContactBodyCompany{Name: "Beispiel GmbH", VatRegistrationId: vatId},
+				Addresses: golexoffice.ContactBodyAddresses{
+					Billing: []*golexoffice.ContactBodyBilling{{CountryCode: countryCode}},
+				},
+			}
+		}
+
+		for _, tc := range []struct {
+			contact golexoffice.ContactsReturnContent
+			service bool
+			taxType string
+		}{
+			{contact("DE", "DE123456789"), true, "net"},
+			{contact("AT", "ATU12345678"), true, "intraCommunitySupply"},
+			{contact("AT", ""), true, "net"},
+			{contact("CH", ""), true, "thirdPartyCountryService"},
+			{contact("CH", ""), false, "thirdPartyCountryDelivery"},
+		} {
+			taxType, err := lexOffice.SuggestTaxType(tc.contact, tc.service)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.taxType, taxType)
+		}
+
+		_, err := lexOffice.SuggestTaxType(golexoffice.ContactsReturnContent{}, true)
+		assert.ErrorContains(t, err, "no billing address")
+	})
+
+	t.Run("cache disabled", func(t *testing.T) {
+		uncached := golexoffice.NewConfig("token", nil)
+		uncached.SetBaseUrl(server.URL)
+		uncached.SetCacheTTL(0)
+
+		before := requests
+		_, err := uncached.Countries()
+		assert.NoError(t, err)
+		_, err = uncached.Countries()
+		assert.NoError(t, err)
+		assert.Equal(t, before+2, requests)
+	})
+}
@@ -0,0 +1,117 @@
+package golexoffice
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// CreditNoteBody is to define body data
+//
+// Address, line items and tax conditions are the same as for invoices, but
+// lexoffice only accepts "custom" and "text" line items on credit notes.
+type CreditNoteBody struct {
+	Id             string                   `json:"id,omitempty"`
+	OrganizationId string                   `json:"organizationId,omitempty"`
+	CreatedDate    string                   `json:"createdDate,omitempty"`
+	UpdatedDate    string                   `json:"updatedDate,omitempty"`
+	Version        int                      `json:"version,omitempty"`
+	Archived       bool                     `json:"archived,omitempty"`
+	VoucherStatus  string                   `json:"voucherStatus,omitempty"`
+	VoucherNumber  string                   `json:"voucherNumber,omitempty"`
+	VoucherDate    string                   `json:"voucherDate"`
+	Address        InvoiceBodyAddress       `json:"address"`
+	LineItems      []InvoiceBodyLineItems   `json:"lineItems"`
+	TotalPrice     InvoiceBodyTotalPrice    `json:"totalPrice"`
+	TaxAmounts     []InvoiceBodyTaxAmounts  `json:"taxAmounts,omitempty"`
+	TaxConditions  InvoiceBodyTaxConditions `json:"taxConditions"`
+	Title          string                   `json:"title,omitempty"`
+	Introduction   string                   `json:"introduction,omitempty"`
+	Remark         string                   `json:"remark,omitempty"`
+	Language       string                   `json:"language,omitempty"`
+	PrintLayoutId  string                   `json:"printLayoutId,omitempty"`
+	Files          *DocumentFile            `json:"files,omitempty"`
+
+	// PrecedingSalesVoucherId is to link the credit note to an invoice, it is
+	// sent as query parameter only
+	PrecedingSalesVoucherId string `json:"-"`
+}
+
+// CreditNoteReturn is to decode json data
+type CreditNoteReturn struct {
+	Id          string `json:"id"`
+	ResourceUri string `json:"resourceUri"`
+	CreatedDate string `json:"createdDate"`
+	UpdatedDate string `json:"updatedDate"`
+	Version     int    `json:"version"`
+}
+
+// CreditNote is to get a credit note by id
+func (c *Config) CreditNote(id string) (CreditNoteBody, error) {
+
+	// Send request
+	response, err := c.Send("/v1/credit-notes/"+id, nil, "GET", "application/json")
+	if err != nil {
+		return CreditNoteBody{}, err
+	}
+
+	// Close request
+	defer response.Body.Close()
+
+	// Decode data
+	var decode CreditNoteBody
+
+	err = json.NewDecoder(response.Body).Decode(&decode)
+	if err != nil {
+		return CreditNoteBody{}, err
+	}
+
+	// Return data
+	return decode, nil
+
+}
+
+// AddCreditNote is to create a credit note
+//
+// Like with AddInvoice, a VoucherStatus of "open" finalizes the credit note.
+func (c *Config) AddCreditNote(body CreditNoteBody) (CreditNoteReturn, error) {
+
+	query := salesVoucherQuery(body.VoucherStatus == "open", body.PrecedingSalesVoucherId)
+	body.VoucherStatus = "" // unset for the request
+
+	// Convert body
+	convert, err := json.Marshal(body)
+	if err != nil {
+		return CreditNoteReturn{}, err
+	}
+
+	// Send request
+	response, err := c.Send("/v1/credit-notes"+query, bytes.NewBuffer(convert), "POST", "application/json")
+	if err != nil {
+		return CreditNoteReturn{}, err
+	}
+
+	// Close request
+	defer response.Body.Close()
+
+	// Decode data
+	var decode CreditNoteReturn
+
+	err = json.NewDecoder(response.Body).Decode(&decode)
+	if err != nil {
+		return CreditNoteReturn{}, err
+	}
+
+	// Return data
+	return decode, nil
+
+}
+
+// CreditNoteDocument is to render the pdf of a credit note
+func (c *Config) CreditNoteDocument(id string) (DocumentFile, error) {
+	return c.renderDocument("/v1/credit-notes/" + id)
+}
+
+// CreditNoteDeeplink is to link to a credit note in the lexoffice UI
+func (c *Config) CreditNoteDeeplink(id string, action DeeplinkAction) string {
+	return c.deeplink("credit-notes", action, id)
+}
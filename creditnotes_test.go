@@ -0,0 +1,117 @@
+package golexoffice_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hostwithquantum/golexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreditNotes(t *testing.T) {
+	server := creditNotesMock()
+	defer server.Close()
+
+	lexOffice := golexoffice.NewConfig("token", nil)
+	lexOffice.SetBaseUrl(server.URL)
+
+	t.Run("create=pursued", func(t *testing.T) {
+		resp, err := lexOffice.AddCreditNote(golexoffice.CreditNoteBody{
+			VoucherStatus:           "open",
+			VoucherDate:             "2023-06-14T00:00:00.000+02:00",
+			PrecedingSalesVoucherId: "0cf8142b-6f54-4c96-9766-6f44a9a4814b",
+			Address: golexoffice.InvoiceBodyAddress{
+				ContactId: "e9066f04-8cc7-4616-93f8-ac9ecc8479c8",
+			},
+			LineItems: []golexoffice.InvoiceBodyLineItems{{
+				Type:     "custom",
+				Name:     "Refund",
+				Quantity: 1,
+				UnitName: "Stück",
+				UnitPrice: golexoffice.InvoiceBodyUnitPrice{
+					Currency:          "EUR",
+					NetAmount:         10,
+					TaxRatePercentage: 19,
+				},
+			}},
+			TotalPrice:    golexoffice.InvoiceBodyTotalPrice{Currency: "EUR"},
+			TaxConditions: golexoffice.InvoiceBodyTaxConditions{TaxType: "net"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "e9066f04-8cc7-4616-93f8-ac9ecc8479c9", resp.Id)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		creditNote, err := lexOffice.CreditNote("e9066f04-8cc7-4616-93f8-ac9ecc8479c9")
+		assert.NoError(t, err)
+		assert.Equal(t, "GS0001", creditNote.VoucherNumber)
+		assert.Equal(t, "open", creditNote.VoucherStatus)
+		assert.Equal(t, "2cde7c5b-5c3f-4d2a-a4e0-6b3e7c8b0a11", creditNote.Files.DocumentFileId)
+	})
+
+	t.Run("document", func(t *testing.T) {
+		document, err := lexOffice.CreditNoteDocument("e9066f04-8cc7-4616-93f8-ac9ecc8479c9")
+		assert.NoError(t, err)
+		assert.Equal(t, "2cde7c5b-5c3f-4d2a-a4e0-6b3e7c8b0a11", document.DocumentFileId)
+	})
+
+	t.Run("deeplink", func(t *testing.T) {
+		assert.Equal(t,
+			"https://app.lexoffice.de/permalink/credit-notes/edit/e9066f04-8cc7-4616-93f8-ac9ecc8479c9",
+			lexOffice.CreditNoteDeeplink("e9066f04-8cc7-4616-93f8-ac9ecc8479c9", golexoffice.DeeplinkEdit))
+	})
+}
+
+func creditNotesMock() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/credit-notes":
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			if _, ok := body["voucherStatus"]; ok ||
+				r.URL.Query().Get("finalize") != "true" ||
+				r.URL.Query().Get("precedingSalesVoucherId") != "0cf8142b-6f54-4c96-9766-6f44a9a4814b" {
+				w.WriteHeader(http.StatusNotAcceptable)
+				//nolint:errcheck
+				w.Write([]byte(`{"status": 406, "error": "Not Acceptable", "message": "unexpected request"}`))
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "e9066f04-8cc7-4616-93f8-ac9ecc8479c9",
+				"resourceUri": "https://api.lexoffice.io/v1/credit-notes/e9066f04-8cc7-4616-93f8-ac9ecc8479c9",
+				"createdDate": "2023-06-14T10:15:12.123+02:00",
+				"updatedDate": "2023-06-14T10:15:12.123+02:00",
+				"version": 1
+			}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/credit-notes/e9066f04-8cc7-4616-93f8-ac9ecc8479c9":
+			w.WriteHeader(http.StatusOK)
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "e9066f04-8cc7-4616-93f8-ac9ecc8479c9",
+				"version": 2,
+				"voucherStatus": "open",
+				"voucherNumber": "GS0001",
+				"voucherDate": "2023-06-14T00:00:00.000+02:00",
+				"address": {"contactId": "e9066f04-8cc7-4616-93f8-ac9ecc8479c8"},
+				"lineItems": [],
+				"totalPrice": {"currency": "EUR", "totalNetAmount": 10, "totalGrossAmount": 11.9, "totalTaxAmount": 1.9},
+				"taxConditions": {"taxType": "net"},
+				"files": {"documentFileId": "2cde7c5b-5c3f-4d2a-a4e0-6b3e7c8b0a11"}
+			}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/credit-notes/e9066f04-8cc7-4616-93f8-ac9ecc8479c9/document":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"documentFileId": "2cde7c5b-5c3f-4d2a-a4e0-6b3e7c8b0a11"}`)) //nolint:errcheck
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
@@ -0,0 +1,58 @@
+package golexoffice
+
+import (
+	"net/url"
+	"strings"
+)
+
+const appURL = "https://app.lexoffice.de"
+
+// DeeplinkAction is to open a voucher or contact for viewing or editing
+type DeeplinkAction string
+
+const (
+	DeeplinkView DeeplinkAction = "view"
+	DeeplinkEdit DeeplinkAction = "edit"
+)
+
+// InvoiceDeeplink is to link to an invoice in the lexoffice UI
+func (c *Config) InvoiceDeeplink(id string, action DeeplinkAction) string {
+	return c.deeplink("invoices", action, id)
+}
+
+// QuotationDeeplink is to link to a quotation in the lexoffice UI
+func (c *Config) QuotationDeeplink(id string, action DeeplinkAction) string {
+	return c.deeplink("quotations", action, id)
+}
+
+// ContactDeeplink is to link to a contact in the lexoffice UI
+func (c *Config) ContactDeeplink(id string, action DeeplinkAction) string {
+	return c.deeplink("contacts", action, id)
+}
+
+// deeplink is to build a link into the lexoffice UI
+func (c *Config) deeplink(resource string, action DeeplinkAction, id string) string {
+	return c.appBaseUrl() + "/permalink/" + resource + "/" + string(action) + "/" + id
+}
+
+// appBaseUrl is to get the url of the lexoffice UI, which belongs to the api
+// of the base url (e.g. https://api-sandbox.grld.eu is https://app-sandbox.grld.eu)
+//
+// Base urls whose host doesn't start with an "api." or "api-" label (e.g. a
+// proxy) link to production, use SetAppUrl for those.
+func (c *Config) appBaseUrl() string {
+	if c.appUrl != "" {
+		return strings.TrimSuffix(c.appUrl, "/")
+	}
+
+	if c.baseUrl == "" || strings.TrimSuffix(c.baseUrl, "/") == baseURL {
+		return appURL
+	}
+
+	parsed, err := url.Parse(c.baseUrl)
+	if err != nil || !(strings.HasPrefix(parsed.Host, "api.") || strings.HasPrefix(parsed.Host, "api-")) {
+		return appURL
+	}
+
+	return parsed.Scheme + "://app" + strings.TrimPrefix(parsed.Host, "api")
+}
@@ -0,0 +1,41 @@
+package golexoffice_test
+
+import (
+	"testing"
+
+	"github.com/hostwithquantum/golexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeeplinks(t *testing.T) {
+	id := "e9066f04-8cc7-4616-93f8-ac9ecc8479c8"
+
+	t.Run("production", func(t *testing.T) {
+		lexOffice := golexoffice.NewConfig("token", nil)
+
+		assert.Equal(t, "https://app.lexoffice.de/permalink/invoices/view/"+id, lexOffice.InvoiceDeeplink(id, golexoffice.DeeplinkView))
+		assert.Equal(t, "https://app.lexoffice.de/permalink/quotations/edit/"+id, lexOffice.QuotationDeeplink(id, golexoffice.DeeplinkEdit))
+		assert.Equal(t, "https://app.lexoffice.de/permalink/contacts/view/"+id, lexOffice.ContactDeeplink(id, golexoffice.DeeplinkView))
+	})
+
+	t.Run("sandbox", func(t *testing.T) {
+		lexOffice := golexoffice.NewConfig("token", nil)
+		lexOffice.SetBaseUrl("https://api-sandbox.grld.eu")
+
+		assert.Equal(t, "https://app-sandbox.grld.eu/permalink/invoices/edit/"+id, lexOffice.InvoiceDeeplink(id, golexoffice.DeeplinkEdit))
+		assert.Equal(t, "https://app-sandbox.grld.eu/permalink/credit-notes/view/"+id, lexOffice.CreditNoteDeeplink(id, golexoffice.DeeplinkView))
+	})
+
+	t.Run("app url", func(t *testing.T) {
+		lexOffice := golexoffice.NewConfig("token", nil)
+		lexOffice.SetBaseUrl("http://localhost:8080/lexoffice")
+		assert.Equal(t, "https://app.lexoffice.de/permalink/contacts/edit/"+id, lexOffice.ContactDeeplink(id, golexoffice.DeeplinkEdit))
+
+		// a proxy, not the api of lexoffice
+		lexOffice.SetBaseUrl("https://apigateway.internal.example")
+		assert.Equal(t, "https://app.lexoffice.de/permalink/contacts/edit/"+id, lexOffice.ContactDeeplink(id, golexoffice.DeeplinkEdit))
+
+		lexOffice.SetAppUrl("https://lexoffice.example.com/")
+		assert.Equal(t, "https://lexoffice.example.com/permalink/order-confirmations/view/"+id, lexOffice.OrderConfirmationDeeplink(id, golexoffice.DeeplinkView))
+	})
+}
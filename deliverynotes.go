@@ -0,0 +1,138 @@
+package golexoffice
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// DeliveryNoteBody is to define body data
+type DeliveryNoteBody struct {
+	Id                 string                        `json:"id,omitempty"`
+	OrganizationId     string                        `json:"organizationId,omitempty"`
+	CreatedDate        string                        `json:"createdDate,omitempty"`
+	UpdatedDate        string                        `json:"updatedDate,omitempty"`
+	Version            int                           `json:"version,omitempty"`
+	Archived           bool                          `json:"archived,omitempty"`
+	VoucherStatus      string                        `json:"voucherStatus,omitempty"`
+	VoucherNumber      string                        `json:"voucherNumber,omitempty"`
+	VoucherDate        string                        `json:"voucherDate"`
+	Address            InvoiceBodyAddress            `json:"address"`
+	LineItems          []InvoiceBodyLineItems        `json:"lineItems"`
+	TaxConditions      InvoiceBodyTaxConditions      `json:"taxConditions"`
+	ShippingConditions InvoiceBodyShippingConditions `json:"shippingConditions"`
+	Title              string                        `json:"title,omitempty"`
+	Introduction       string                        `json:"introduction,omitempty"`
+	Remark             string                        `json:"remark,omitempty"`
+	DeliveryTerms      string                        `json:"deliveryTerms,omitempty"`
+	Language           string                        `json:"language,omitempty"`
+	PrintLayoutId      string                        `json:"printLayoutId,omitempty"`
+	Files              *DocumentFile                 `json:"files,omitempty"`
+
+	// RelatedVouchers are read-only, they contain e.g. the invoice which was
+	// pursued from the delivery note
+	RelatedVouchers []RelatedVoucher `json:"relatedVouchers,omitempty"`
+
+	// PrecedingSalesVoucherId is to pursue the delivery note from an order
+	// confirmation, it is sent as query parameter only
+	PrecedingSalesVoucherId string `json:"-"`
+}
+
+// DeliveryNoteReturn is to decode json data
+type DeliveryNoteReturn struct {
+	Id          string `json:"id"`
+	ResourceUri string `json:"resourceUri"`
+	CreatedDate string `json:"createdDate"`
+	UpdatedDate string `json:"updatedDate"`
+	Version     int    `json:"version"`
+}
+
+// DeliveryNote is to get a delivery note by id
+func (c *Config) DeliveryNote(id string) (DeliveryNoteBody, error) {
+
+	// Send request
+	response, err := c.Send("/v1/delivery-notes/"+id, nil, "GET", "application/json")
+	if err != nil {
+		return DeliveryNoteBody{}, err
+	}
+
+	// Close request
+	defer response.Body.Close()
+
+	// Decode data
+	var decode DeliveryNoteBody
+
+	err = json.NewDecoder(response.Body).Decode(&decode)
+	if err != nil {
+		return DeliveryNoteBody{}, err
+	}
+
+	// Return data
+	return decode, nil
+
+}
+
+// AddDeliveryNote is to create a delivery note
+func (c *Config) AddDeliveryNote(body DeliveryNoteBody) (DeliveryNoteReturn, error) {
+
+	query := salesVoucherQuery(false, body.PrecedingSalesVoucherId)
+	body.VoucherStatus = "" // unset for the request
+	body.RelatedVouchers = nil
+
+	// Convert body
+	convert, err := json.Marshal(body)
+	if err != nil {
+		return DeliveryNoteReturn{}, err
+	}
+
+	// Send request
+	response, err := c.Send("/v1/delivery-notes"+query, bytes.NewBuffer(convert), "POST", "application/json")
+	if err != nil {
+		return DeliveryNoteReturn{}, err
+	}
+
+	// Close request
+	defer response.Body.Close()
+
+	// Decode data
+	var decode DeliveryNoteReturn
+
+	err = json.NewDecoder(response.Body).Decode(&decode)
+	if err != nil {
+		return DeliveryNoteReturn{}, err
+	}
+
+	// Return data
+	return decode, nil
+
+}
+
+// DeliveryNoteDocument is to render the pdf of a delivery note
+func (c *Config) DeliveryNoteDocument(id string) (DocumentFile, error) {
+	return c.renderDocument("/v1/delivery-notes/" + id)
+}
+
+// DeliveryNoteBody is to pursue an order confirmation into a delivery note
+func (o OrderConfirmationBody) DeliveryNoteBody(voucherDate string) DeliveryNoteBody {
+	return DeliveryNoteBody{
+		VoucherDate:             voucherDate,
+		Address:                 o.Address,
+		LineItems:               pursueLineItems(o.LineItems),
+		TaxConditions:           o.TaxConditions,
+		ShippingConditions:      o.ShippingConditions,
+		DeliveryTerms:           o.DeliveryTerms,
+		Language:                o.Language,
+		PrintLayoutId:           o.PrintLayoutId,
+		PrecedingSalesVoucherId: o.Id,
+	}
+}
+
+// InvoiceIds is to get the ids of the invoices linked to the delivery note
+func (d DeliveryNoteBody) InvoiceIds() []string {
+	var ids []string
+	for _, related := range d.RelatedVouchers {
+		if related.VoucherType == VoucherTypeInvoice {
+			ids = append(ids, related.Id)
+		}
+	}
+	return ids
+}
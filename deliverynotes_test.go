@@ -0,0 +1,87 @@
+package golexoffice_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hostwithquantum/golexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeliveryNotes(t *testing.T) {
+	server := deliveryNotesMock()
+	defer server.Close()
+
+	lexOffice := golexoffice.NewConfig("token", nil)
+	lexOffice.SetBaseUrl(server.URL)
+
+	t.Run("create=pursued", func(t *testing.T) {
+		orderConfirmation := golexoffice.OrderConfirmationBody{
+			Id:            "fd6d2a1a-0e93-4b5f-9f6e-14d4b2f6d0c1",
+			Address:       golexoffice.InvoiceBodyAddress{ContactId: "e9066f04-8cc7-4616-93f8-ac9ecc8479c8"},
+			DeliveryTerms: "Lieferung frei Haus",
+		}
+
+		body := orderConfirmation.DeliveryNoteBody("2023-06-20T00:00:00.000+02:00")
+		assert.Equal(t, "Lieferung frei Haus", body.DeliveryTerms)
+
+		resp, err := lexOffice.AddDeliveryNote(body)
+		assert.NoError(t, err)
+		assert.Equal(t, "3a2e8a6c-5b61-4b0d-8a1e-0b6b0a0b4d2f", resp.Id)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		deliveryNote, err := lexOffice.DeliveryNote("3a2e8a6c-5b61-4b0d-8a1e-0b6b0a0b4d2f")
+		assert.NoError(t, err)
+		assert.Equal(t, "LS0001", deliveryNote.VoucherNumber)
+		assert.Equal(t, []string{"0cf8142b-6f54-4c96-9766-6f44a9a4814b"}, deliveryNote.InvoiceIds())
+	})
+
+	t.Run("document", func(t *testing.T) {
+		document, err := lexOffice.DeliveryNoteDocument("3a2e8a6c-5b61-4b0d-8a1e-0b6b0a0b4d2f")
+		assert.NoError(t, err)
+		assert.Equal(t, "a9a6fd21-5e3e-4b1f-97c5-1d0f3d2b6e8a", document.DocumentFileId)
+	})
+}
+
+func deliveryNotesMock() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/delivery-notes" &&
+			r.URL.Query().Get("precedingSalesVoucherId") == "fd6d2a1a-0e93-4b5f-9f6e-14d4b2f6d0c1":
+			w.WriteHeader(http.StatusCreated)
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "3a2e8a6c-5b61-4b0d-8a1e-0b6b0a0b4d2f",
+				"resourceUri": "https://api.lexoffice.io/v1/delivery-notes/3a2e8a6c-5b61-4b0d-8a1e-0b6b0a0b4d2f",
+				"version": 1
+			}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/delivery-notes/3a2e8a6c-5b61-4b0d-8a1e-0b6b0a0b4d2f":
+			w.WriteHeader(http.StatusOK)
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "3a2e8a6c-5b61-4b0d-8a1e-0b6b0a0b4d2f",
+				"version": 2,
+				"voucherStatus": "unchecked",
+				"voucherNumber": "LS0001",
+				"voucherDate": "2023-06-20T00:00:00.000+02:00",
+				"address": {"contactId": "e9066f04-8cc7-4616-93f8-ac9ecc8479c8"},
+				"lineItems": [],
+				"taxConditions": {"taxType": "net"},
+				"shippingConditions": {"shippingType": "delivery"},
+				"relatedVouchers": [
+					{"id": "fd6d2a1a-0e93-4b5f-9f6e-14d4b2f6d0c1", "voucherNumber": "AB0001", "voucherType": "orderconfirmation"},
+					{"id": "0cf8142b-6f54-4c96-9766-6f44a9a4814b", "voucherNumber": "RE1001", "voucherType": "invoice"}
+				]
+			}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/delivery-notes/3a2e8a6c-5b61-4b0d-8a1e-0b6b0a0b4d2f/document":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"documentFileId": "a9a6fd21-5e3e-4b1f-97c5-1d0f3d2b6e8a"}`)) //nolint:errcheck
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
@@ -0,0 +1,112 @@
+package golexoffice
+
+import (
+	"encoding/json"
+)
+
+// DownPaymentInvoiceBody is to decode json data, down payment invoices are
+// read-only in the API
+type DownPaymentInvoiceBody struct {
+	Id                 string                        `json:"id"`
+	OrganizationId     string                        `json:"organizationId"`
+	CreatedDate        string                        `json:"createdDate"`
+	UpdatedDate        string                        `json:"updatedDate"`
+	Version            int                           `json:"version"`
+	Archived           bool                          `json:"archived"`
+	VoucherStatus      string                        `json:"voucherStatus"`
+	VoucherNumber      string                        `json:"voucherNumber"`
+	VoucherDate        string                        `json:"voucherDate"`
+	DueDate            string                        `json:"dueDate"`
+	Address            InvoiceBodyAddress            `json:"address"`
+	LineItems          []InvoiceBodyLineItems        `json:"lineItems"`
+	TotalPrice         InvoiceBodyTotalPrice         `json:"totalPrice"`
+	TaxAmounts         []InvoiceBodyTaxAmounts       `json:"taxAmounts"`
+	TaxConditions      InvoiceBodyTaxConditions      `json:"taxConditions"`
+	PaymentConditions  *InvoiceBodyPaymentConditions `json:"paymentConditions"`
+	ShippingConditions InvoiceBodyShippingConditions `json:"shippingConditions"`
+	ClosingInvoiceId   string                        `json:"closingInvoiceId"`
+	Title              string                        `json:"title"`
+	Introduction       string                        `json:"introduction"`
+	Remark             string                        `json:"remark"`
+	Language           string                        `json:"language"`
+	Files              *DocumentFile                 `json:"files"`
+}
+
+// DownPaymentInvoice is to get a down payment invoice by id
+func (c *Config) DownPaymentInvoice(id string) (DownPaymentInvoiceBody, error) {
+
+	// Send request
+	response, err := c.Send("/v1/down-payment-invoices/"+id, nil, "GET", "application/json")
+	if err != nil {
+		return DownPaymentInvoiceBody{}, err
+	}
+
+	// Close request
+	defer response.Body.Close()
+
+	// Decode data
+	var decode DownPaymentInvoiceBody
+
+	err = json.NewDecoder(response.Body).Decode(&decode)
+	if err != nil {
+		return DownPaymentInvoiceBody{}, err
+	}
+
+	// Return data
+	return decode, nil
+
+}
+
+// DownPaymentInvoiceDocument is to render the pdf of a down payment invoice
+func (c *Config) DownPaymentInvoiceDocument(id string) (DocumentFile, error) {
+	return c.renderDocument("/v1/down-payment-invoices/" + id)
+}
+
+// Deduction is to deduct the down payment invoice from a closing invoice
+//
+// The tax rate is only set when the down payment invoice has a single one.
+func (d DownPaymentInvoiceBody) Deduction() InvoiceBodyDownPaymentDeductions {
+	deduction := InvoiceBodyDownPaymentDeductions{
+		Id:                  d.Id,
+		VoucherType:         VoucherTypeDownPaymentInvoice,
+		Title:               d.Title,
+		VoucherNumber:       d.VoucherNumber,
+		VoucherDate:         d.VoucherDate,
+		ReceivedNetAmount:   toFloat(d.TotalPrice.TotalNetAmount),
+		ReceivedTaxAmount:   toFloat(d.TotalPrice.TotalTaxAmount),
+		ReceivedGrossAmount: toFloat(d.TotalPrice.TotalGrossAmount),
+	}
+
+	if len(d.TaxAmounts) == 1 {
+		deduction.TaxRatePercentage = float64(d.TaxAmounts[0].TaxRatePercentage)
+	}
+
+	return deduction
+}
+
+// DeductDownPayments is to turn the invoice into a closing invoice, which
+// deducts the down payment invoices
+func (i *InvoiceBody) DeductDownPayments(downPaymentInvoices ...DownPaymentInvoiceBody) {
+	i.ClosingInvoice = true
+	for _, downPaymentInvoice := range downPaymentInvoices {
+		i.DownPaymentDeductions = append(i.DownPaymentDeductions, downPaymentInvoice.Deduction())
+	}
+}
+
+// toFloat is to read the amounts which are typed as interface{}
+func toFloat(amount interface{}) float64 {
+	switch v := amount.(type) {
+	case float64:
+		return v
+	case float32:
+		return float64(v)
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case json.Number:
+		f, _ := v.Float64()
+		return f
+	}
+	return 0
+}
@@ -0,0 +1,70 @@
+package golexoffice_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hostwithquantum/golexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDownPaymentInvoices(t *testing.T) {
+	server := downPaymentInvoicesMock()
+	defer server.Close()
+
+	lexOffice := golexoffice.NewConfig("token", nil)
+	lexOffice.SetBaseUrl(server.URL)
+
+	downPaymentInvoice, err := lexOffice.DownPaymentInvoice("9a3e4f5b-5c8e-4b4a-8f2c-1d3e5f7a9b0c")
+	assert.NoError(t, err)
+	assert.Equal(t, "AR0001", downPaymentInvoice.VoucherNumber)
+
+	deduction := downPaymentInvoice.Deduction()
+	assert.Equal(t, golexoffice.VoucherTypeDownPaymentInvoice, deduction.VoucherType)
+	assert.Equal(t, 1000.0, deduction.ReceivedNetAmount)
+	assert.Equal(t, 190.0, deduction.ReceivedTaxAmount)
+	assert.Equal(t, 1190.0, deduction.ReceivedGrossAmount)
+	assert.Equal(t, 19.0, deduction.TaxRatePercentage)
+
+	t.Run("closing invoice", func(t *testing.T) {
+		body := golexoffice.InvoiceBody{}
+		body.DeductDownPayments(downPaymentInvoice)
+
+		encoded, err := json.Marshal(body)
+		assert.NoError(t, err)
+
+		var decoded map[string]interface{}
+		assert.NoError(t, json.Unmarshal(encoded, &decoded))
+		assert.Equal(t, true, decoded["closingInvoice"])
+		assert.Len(t, decoded["downPaymentDeductions"], 1)
+	})
+}
+
+func downPaymentInvoicesMock() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodGet && r.URL.Path == "/v1/down-payment-invoices/9a3e4f5b-5c8e-4b4a-8f2c-1d3e5f7a9b0c" {
+			w.WriteHeader(http.StatusOK)
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "9a3e4f5b-5c8e-4b4a-8f2c-1d3e5f7a9b0c",
+				"version": 2,
+				"voucherStatus": "paid",
+				"voucherNumber": "AR0001",
+				"voucherDate": "2023-03-01T00:00:00.000+01:00",
+				"address": {"contactId": "e9066f04-8cc7-4616-93f8-ac9ecc8479c8"},
+				"lineItems": [],
+				"totalPrice": {"currency": "EUR", "totalNetAmount": 1000.00, "totalGrossAmount": 1190.00, "totalTaxAmount": 190.00},
+				"taxAmounts": [{"taxRatePercentage": 19, "taxAmount": 190.00, "netAmount": 1000.00}],
+				"taxConditions": {"taxType": "net"},
+				"title": "Abschlagsrechnung"
+			}`))
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+	}))
+}
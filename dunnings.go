@@ -0,0 +1,119 @@
+package golexoffice
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// DunningBody is to define body data
+//
+// Dunnings are always pursued from an invoice, the line items contain the
+// dunning fees only (see NewDunningFee).
+type DunningBody struct {
+	Id                 string                        `json:"id,omitempty"`
+	OrganizationId     string                        `json:"organizationId,omitempty"`
+	CreatedDate        string                        `json:"createdDate,omitempty"`
+	UpdatedDate        string                        `json:"updatedDate,omitempty"`
+	Version            int                           `json:"version,omitempty"`
+	Archived           bool                          `json:"archived,omitempty"`
+	VoucherDate        string                        `json:"voucherDate"`
+	Address            InvoiceBodyAddress            `json:"address"`
+	LineItems          []InvoiceBodyLineItems        `json:"lineItems"`
+	TotalPrice         InvoiceBodyTotalPrice         `json:"totalPrice"`
+	TaxAmounts         []InvoiceBodyTaxAmounts       `json:"taxAmounts,omitempty"`
+	TaxConditions      InvoiceBodyTaxConditions      `json:"taxConditions"`
+	ShippingConditions InvoiceBodyShippingConditions `json:"shippingConditions"`
+	Title              string                        `json:"title,omitempty"`
+	Introduction       string                        `json:"introduction,omitempty"`
+	Remark             string                        `json:"remark,omitempty"`
+	Language           string                        `json:"language,omitempty"`
+	Files              *DocumentFile                 `json:"files,omitempty"`
+	RelatedVouchers    []RelatedVoucher              `json:"relatedVouchers,omitempty"`
+}
+
+// DunningReturn is to decode json data
+type DunningReturn struct {
+	Id          string `json:"id"`
+	ResourceUri string `json:"resourceUri"`
+	CreatedDate string `json:"createdDate"`
+	UpdatedDate string `json:"updatedDate"`
+	Version     int    `json:"version"`
+}
+
+// NewDunningFee is to create a (tax free) dunning fee line item
+func NewDunningFee(name, currency string, amount float64) InvoiceBodyLineItems {
+	return InvoiceBodyLineItems{
+		Type:     "custom",
+		Name:     name,
+		Quantity: 1,
+		UnitName: "Stück",
+		UnitPrice: InvoiceBodyUnitPrice{
+			Currency:          currency,
+			NetAmount:         amount,
+			TaxRatePercentage: 0,
+		},
+	}
+}
+
+// Dunning is to get a dunning by id
+func (c *Config) Dunning(id string) (DunningBody, error) {
+
+	// Send request
+	response, err := c.Send("/v1/dunnings/"+id, nil, "GET", "application/json")
+	if err != nil {
+		return DunningBody{}, err
+	}
+
+	// Close request
+	defer response.Body.Close()
+
+	// Decode data
+	var decode DunningBody
+
+	err = json.NewDecoder(response.Body).Decode(&decode)
+	if err != nil {
+		return DunningBody{}, err
+	}
+
+	// Return data
+	return decode, nil
+
+}
+
+// PursueDunning is to create a dunning for an invoice
+func (c *Config) PursueDunning(invoiceId string, body DunningBody) (DunningReturn, error) {
+
+	body.RelatedVouchers = nil
+
+	// Convert body
+	convert, err := json.Marshal(body)
+	if err != nil {
+		return DunningReturn{}, err
+	}
+
+	// Send request
+	response, err := c.Send("/v1/dunnings"+salesVoucherQuery(false, invoiceId), bytes.NewBuffer(convert), "POST", "application/json")
+	if err != nil {
+		return DunningReturn{}, err
+	}
+
+	// Close request
+	defer response.Body.Close()
+
+	// Decode data
+	var decode DunningReturn
+
+	err = json.NewDecoder(response.Body).Decode(&decode)
+	if err != nil {
+		return DunningReturn{}, err
+	}
+
+	// Return data
+	return decode, nil
+
+}
+
+// DunningDocument is to render the pdf of a dunning
+func (c *Config) DunningDocument(id string) (DocumentFile, error) {
+	return c.renderDocument("/v1/dunnings/" + id)
+}
@@ -0,0 +1,91 @@
+package golexoffice_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hostwithquantum/golexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDunnings(t *testing.T) {
+	server := dunningsMock()
+	defer server.Close()
+
+	lexOffice := golexoffice.NewConfig("token", nil)
+	lexOffice.SetBaseUrl(server.URL)
+
+	t.Run("pursue", func(t *testing.T) {
+		resp, err := lexOffice.PursueDunning("0cf8142b-6f54-4c96-9766-6f44a9a4814b", golexoffice.DunningBody{
+			VoucherDate: "2023-07-01T00:00:00.000+02:00",
+			LineItems: []golexoffice.InvoiceBodyLineItems{
+				golexoffice.NewDunningFee("Mahngebühr", "EUR", 5),
+			},
+			TotalPrice:    golexoffice.InvoiceBodyTotalPrice{Currency: "EUR"},
+			TaxConditions: golexoffice.InvoiceBodyTaxConditions{TaxType: "net"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "7d3f9c1a-0a8e-4d4b-9f6c-1b2a3c4d5e6f", resp.Id)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		dunning, err := lexOffice.Dunning("7d3f9c1a-0a8e-4d4b-9f6c-1b2a3c4d5e6f")
+		assert.NoError(t, err)
+		assert.Equal(t, "Mahnung", dunning.Title)
+		assert.Len(t, dunning.RelatedVouchers, 1)
+		assert.Equal(t, golexoffice.VoucherTypeInvoice, dunning.RelatedVouchers[0].VoucherType)
+	})
+
+	t.Run("document", func(t *testing.T) {
+		document, err := lexOffice.DunningDocument("7d3f9c1a-0a8e-4d4b-9f6c-1b2a3c4d5e6f")
+		assert.NoError(t, err)
+		assert.Equal(t, "c5e1f6a2-8b3d-4e9f-a0b1-2c3d4e5f6a7b", document.DocumentFileId)
+	})
+}
+
+func dunningsMock() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/dunnings":
+			var body golexoffice.DunningBody
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil || len(body.LineItems) != 1 ||
+				r.URL.Query().Get("precedingSalesVoucherId") != "0cf8142b-6f54-4c96-9766-6f44a9a4814b" {
+				w.WriteHeader(http.StatusNotAcceptable)
+				//nolint:errcheck
+				w.Write([]byte(`{"status": 406, "error": "Not Acceptable", "message": "unexpected request"}`))
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "7d3f9c1a-0a8e-4d4b-9f6c-1b2a3c4d5e6f",
+				"resourceUri": "https://api.lexoffice.io/v1/dunnings/7d3f9c1a-0a8e-4d4b-9f6c-1b2a3c4d5e6f",
+				"version": 1
+			}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/dunnings/7d3f9c1a-0a8e-4d4b-9f6c-1b2a3c4d5e6f":
+			w.WriteHeader(http.StatusOK)
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "7d3f9c1a-0a8e-4d4b-9f6c-1b2a3c4d5e6f",
+				"version": 1,
+				"voucherDate": "2023-07-01T00:00:00.000+02:00",
+				"address": {"contactId": "e9066f04-8cc7-4616-93f8-ac9ecc8479c8"},
+				"lineItems": [{"type": "custom", "name": "Mahngebühr", "quantity": 1, "unitPrice": {"currency": "EUR", "netAmount": 5, "taxRatePercentage": 0}}],
+				"totalPrice": {"currency": "EUR", "totalNetAmount": 5, "totalGrossAmount": 5, "totalTaxAmount": 0},
+				"taxConditions": {"taxType": "net"},
+				"shippingConditions": {"shippingType": "none"},
+				"title": "Mahnung",
+				"relatedVouchers": [{"id": "0cf8142b-6f54-4c96-9766-6f44a9a4814b", "voucherNumber": "RE1001", "voucherType": "invoice"}]
+			}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/dunnings/7d3f9c1a-0a8e-4d4b-9f6c-1b2a3c4d5e6f/document":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"documentFileId": "c5e1f6a2-8b3d-4e9f-a0b1-2c3d4e5f6a7b"}`)) //nolint:errcheck
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
@@ -0,0 +1,643 @@
+package golexoffice
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EInvoiceFormat is the syntax of an e-invoice
+type EInvoiceFormat string
+
+const (
+	EInvoiceFormatCII EInvoiceFormat = "cii"
+	EInvoiceFormatUBL EInvoiceFormat = "ubl"
+)
+
+// ublNamespace is the namespace prefix of the UBL invoice and credit note
+const ublNamespace = "urn:oasis:names:specification:ubl:schema:xsd:"
+
+var (
+	pdfLength = regexp.MustCompile(`/Length\s+(\d+)(\s+\d+\s+R)?`)
+	pdfFilter = regexp.MustCompile(`/Filter\s*\[?\s*/(\w+)`)
+)
+
+// EInvoice is a parsed incoming e-invoice (XRechnung or ZUGFeRD), it keeps
+// the original file to upload it with the voucher
+type EInvoice struct {
+	Format           EInvoiceFormat
+	Type             VoucherType
+	InvoiceNumber    string
+	IssueDate        time.Time
+	DueDate          time.Time
+	Currency         string
+	Supplier         EInvoiceParty
+	Lines            []EInvoiceLine
+	TaxAmounts       []EInvoiceTaxAmount
+	TotalNetAmount   float64
+	TotalTaxAmount   float64
+	TotalGrossAmount float64
+
+	data        []byte
+	fileName    string
+	contentType string
+}
+
+type EInvoiceParty struct {
+	Name              string
+	VatRegistrationId string
+	TaxNumber         string
+	Street            string
+	Zip               string
+	City              string
+	CountryCode       string
+	EmailAddress      string
+}
+
+type EInvoiceLine struct {
+	Name           string
+	Quantity       float64
+	UnitCode       string
+	NetAmount      float64
+	TaxRatePercent float64
+}
+
+type EInvoiceTaxAmount struct {
+	TaxRatePercent float64
+	NetAmount      float64
+	TaxAmount      float64
+}
+
+// ParseEInvoice is to parse an e-invoice, either the XML (CII or UBL) or a
+// hybrid PDF (ZUGFeRD, Factur-X) with the XML embedded
+//
+// ZUGFeRD 1.0 (CrossIndustryDocument) is read as CII as well.
+//
+// Encrypted PDFs are not supported. The file must not exceed FileMaxSize,
+// because it is uploaded with the voucher.
+func ParseEInvoice(r io.Reader, fileName string) (*EInvoice, error) {
+	data, err := io.ReadAll(io.LimitReader(r, FileMaxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > FileMaxSize {
+		return nil, fmt.Errorf("e-invoice: %s is too large (max. %d bytes)", fileName, FileMaxSize)
+	}
+
+	invoice := &EInvoice{
+		data:        data,
+		fileName:    fileName,
+		contentType: "application/xml",
+	}
+
+	document := data
+	if bytes.HasPrefix(data, []byte("%PDF-")) {
+		invoice.contentType = "application/pdf"
+
+		document, err = pdfEmbeddedInvoice(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	format, err := eInvoiceFormat(document)
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case EInvoiceFormatCII:
+		err = invoice.parseCII(document)
+	case EInvoiceFormatUBL:
+		err = invoice.parseUBL(document)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("e-invoice: %w", err)
+	}
+
+	return invoice, nil
+}
+
+// VoucherBody is to book the e-invoice as voucher, with one item per tax
+// rate in the category (see PostingCategory)
+//
+// Without a contact id the voucher is booked on the collective contact.
+// Vouchers are in EUR, so invoices in other currencies return an error.
+func (e *EInvoice) VoucherBody(categoryId, contactId string) (VoucherBody, error) {
+	if e.Currency != "EUR" {
+		return VoucherBody{}, fmt.Errorf("e-invoice: %s is in %s, vouchers are in EUR only", e.InvoiceNumber, e.Currency)
+	}
+
+	body := VoucherBody{
+		Type:                 e.Type,
+		VoucherNumber:        e.InvoiceNumber,
+		VoucherDate:          e.IssueDate.Format("2006-01-02"),
+		TotalGrossAmount:     e.TotalGrossAmount,
+		TotalTaxAmount:       e.TotalTaxAmount,
+		TaxType:              "net",
+		UseCollectiveContact: contactId == "",
+		ContactId:            contactId,
+	}
+
+	if !e.DueDate.IsZero() {
+		body.DueDate = e.DueDate.Format("2006-01-02")
+	}
+
+	for _, tax := range e.TaxAmounts {
+		body.VoucherItems = append(body.VoucherItems, VoucherBodyItems{
+			Amount:         tax.NetAmount,
+			TaxAmount:      tax.TaxAmount,
+			TaxRatePercent: tax.TaxRatePercent,
+			CategoryId:     categoryId,
+		})
+	}
+
+	return body, nil
+}
+
+// ContactBody is to create the supplier as vendor, if there is no contact
+// for it yet
+func (e *EInvoice) ContactBody() ContactBody {
+	body := ContactBody{
+		Roles: ContactBodyRoles{Vendor: &ContactBodyVendor{}},
+		Company: &ContactBodyCompany{
+			Name:              e.Supplier.Name,
+			TaxNumber:         e.Supplier.TaxNumber,
+			VatRegistrationId: e.Supplier.VatRegistrationId,
+		},
+		Addresses: &ContactBodyAddresses{
+			Billing: []*ContactBodyBilling{{
+				Street:      e.Supplier.Street,
+				Zip:         e.Supplier.Zip,
+				City:        e.Supplier.City,
+				CountryCode: e.Supplier.CountryCode,
+			}},
+		},
+	}
+
+	if e.Supplier.EmailAddress != "" {
+		body.EmailAddresses = &ContactBodyEmailAddresses{Business: []string{e.Supplier.EmailAddress}}
+	}
+
+	return body
+}
+
+// FileUpload is to upload the original file of the e-invoice
+func (e *EInvoice) FileUpload() FileUpload {
+	return FileUpload{
+		Reader:      bytes.NewReader(e.data),
+		Size:        int64(len(e.data)),
+		FileName:    e.fileName,
+		ContentType: e.contentType,
+	}
+}
+
+// CreateVoucherFromEInvoice is to create the voucher (see EInvoice.VoucherBody)
+// and attach the original file to it
+//
+// When the upload fails, the voucher was created already and its id is returned
+// with the error.
+func (c *Config) CreateVoucherFromEInvoice(invoice *EInvoice, body VoucherBody) (VoucherReturn, error) {
+	voucher, err := c.CreateVoucher(body)
+	if err != nil {
+		return VoucherReturn{}, err
+	}
+
+	_, err = c.AttachFileToVoucher(voucher.Id, invoice.FileUpload())
+	if err != nil {
+		return voucher, fmt.Errorf("e-invoice: attaching %s to voucher %s: %w", invoice.fileName, voucher.Id, err)
+	}
+
+	return voucher, nil
+}
+
+// eInvoiceFormat is to detect the syntax by the root element
+func eInvoiceFormat(document []byte) (EInvoiceFormat, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(document))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return "", fmt.Errorf("e-invoice: no XML invoice found: %w", err)
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case start.Name.Local == "CrossIndustryInvoice" || start.Name.Local == "CrossIndustryDocument":
+			return EInvoiceFormatCII, nil
+		case (start.Name.Local == "Invoice" || start.Name.Local == "CreditNote") && strings.HasPrefix(start.Name.Space, ublNamespace):
+			return EInvoiceFormatUBL, nil
+		}
+
+		return "", fmt.Errorf("e-invoice: unsupported root element %s", start.Name.Local)
+	}
+}
+
+type eInvoiceAmount struct {
+	Value    float64 `xml:",chardata"`
+	Currency string  `xml:"currencyID,attr"`
+}
+
+type eInvoiceQuantity struct {
+	Value    float64 `xml:",chardata"`
+	UnitCode string  `xml:"unitCode,attr"`
+}
+
+type eInvoiceId struct {
+	Value  string `xml:",chardata"`
+	Scheme string `xml:"schemeID,attr"`
+}
+
+// amountIn is to pick the amount in the currency, totals can be given in the
+// accounting currency as well
+func amountIn(amounts []eInvoiceAmount, currency string) float64 {
+	for _, amount := range amounts {
+		if amount.Currency == "" || amount.Currency == currency {
+			return amount.Value
+		}
+	}
+	return 0
+}
+
+// ciiInvoice is the part of the UN/CEFACT Cross Industry Invoice used for vouchers
+type ciiInvoice struct {
+	Id        string `xml:"ExchangedDocument>ID"`
+	TypeCode  string `xml:"ExchangedDocument>TypeCode"`
+	IssueDate string `xml:"ExchangedDocument>IssueDateTime>DateTimeString"`
+	Lines     []struct {
+		Name      string           `xml:"SpecifiedTradeProduct>Name"`
+		Quantity  eInvoiceQuantity `xml:"SpecifiedLineTradeDelivery>BilledQuantity"`
+		TaxRate   float64          `xml:"SpecifiedLineTradeSettlement>ApplicableTradeTax>RateApplicablePercent"`
+		NetAmount float64          `xml:"SpecifiedLineTradeSettlement>SpecifiedTradeSettlementLineMonetarySummation>LineTotalAmount"`
+	} `xml:"SupplyChainTradeTransaction>IncludedSupplyChainTradeLineItem"`
+	Seller struct {
+		Name    string `xml:"Name"`
+		Address struct {
+			Street      string `xml:"LineOne"`
+			Zip         string `xml:"PostcodeCode"`
+			City        string `xml:"CityName"`
+			CountryCode string `xml:"CountryID"`
+		} `xml:"PostalTradeAddress"`
+		EmailAddress     string       `xml:"URIUniversalCommunication>URIID"`
+		TaxRegistrations []eInvoiceId `xml:"SpecifiedTaxRegistration>ID"`
+	} `xml:"SupplyChainTradeTransaction>ApplicableHeaderTradeAgreement>SellerTradeParty"`
+	Settlement struct {
+		Currency string `xml:"InvoiceCurrencyCode"`
+		Taxes    []struct {
+			TaxAmount float64 `xml:"CalculatedAmount"`
+			NetAmount float64 `xml:"BasisAmount"`
+			TaxRate   float64 `xml:"RateApplicablePercent"`
+		} `xml:"ApplicableTradeTax"`
+		DueDate string `xml:"SpecifiedTradePaymentTerms>DueDateDateTime>DateTimeString"`
+		Totals  struct {
+			NetAmount   float64          `xml:"TaxBasisTotalAmount"`
+			TaxAmount   []eInvoiceAmount `xml:"TaxTotalAmount"`
+			GrossAmount float64          `xml:"GrandTotalAmount"`
+		} `xml:"SpecifiedTradeSettlementHeaderMonetarySummation"`
+	} `xml:"SupplyChainTradeTransaction>ApplicableHeaderTradeSettlement"`
+}
+
+// zugferd1Document is the ZUGFeRD 1.0 predecessor of ciiInvoice, the
+// elements have other names, but the same structure
+type zugferd1Document struct {
+	Id        string `xml:"HeaderExchangedDocument>ID"`
+	TypeCode  string `xml:"HeaderExchangedDocument>TypeCode"`
+	IssueDate string `xml:"HeaderExchangedDocument>IssueDateTime>DateTimeString"`
+	Lines     []struct {
+		Name      string           `xml:"SpecifiedTradeProduct>Name"`
+		Quantity  eInvoiceQuantity `xml:"SpecifiedSupplyChainTradeDelivery>BilledQuantity"`
+		TaxRate   float64          `xml:"SpecifiedSupplyChainTradeSettlement>ApplicableTradeTax>ApplicablePercent"`
+		NetAmount float64          `xml:"SpecifiedSupplyChainTradeSettlement>SpecifiedTradeSettlementMonetarySummation>LineTotalAmount"`
+	} `xml:"SpecifiedSupplyChainTradeTransaction>IncludedSupplyChainTradeLineItem"`
+	Seller struct {
+		Name    string `xml:"Name"`
+		Address struct {
+			Street      string `xml:"LineOne"`
+			Zip         string `xml:"PostcodeCode"`
+			City        string `xml:"CityName"`
+			CountryCode string `xml:"CountryID"`
+		} `xml:"PostalTradeAddress"`
+		EmailAddress     string       `xml:"URIUniversalCommunication>URIID"`
+		TaxRegistrations []eInvoiceId `xml:"SpecifiedTaxRegistration>ID"`
+	} `xml:"SpecifiedSupplyChainTradeTransaction>ApplicableSupplyChainTradeAgreement>SellerTradeParty"`
+	Settlement struct {
+		Currency string `xml:"InvoiceCurrencyCode"`
+		Taxes    []struct {
+			TaxAmount float64 `xml:"CalculatedAmount"`
+			NetAmount float64 `xml:"BasisAmount"`
+			TaxRate   float64 `xml:"ApplicablePercent"`
+		} `xml:"ApplicableTradeTax"`
+		DueDate string `xml:"SpecifiedTradePaymentTerms>DueDateDateTime>DateTimeString"`
+		Totals  struct {
+			NetAmount   float64          `xml:"TaxBasisTotalAmount"`
+			TaxAmount   []eInvoiceAmount `xml:"TaxTotalAmount"`
+			GrossAmount float64          `xml:"GrandTotalAmount"`
+		} `xml:"SpecifiedTradeSettlementMonetarySummation"`
+	} `xml:"SpecifiedSupplyChainTradeTransaction>ApplicableSupplyChainTradeSettlement"`
+}
+
+func (e *EInvoice) parseCII(document []byte) error {
+	var cii ciiInvoice
+	err := xml.Unmarshal(document, &cii)
+	if err != nil {
+		return err
+	}
+
+	// ZUGFeRD 1.0 has no ExchangedDocument, so nothing was decoded
+	if cii.Id == "" && cii.IssueDate == "" {
+		var zugferd1 zugferd1Document
+		err = xml.Unmarshal(document, &zugferd1)
+		if err != nil {
+			return err
+		}
+		cii = ciiInvoice(zugferd1)
+	}
+
+	e.Format = EInvoiceFormatCII
+	e.Type = VoucherTypePurchaseInvoice
+	if cii.TypeCode == "381" {
+		e.Type = VoucherTypePurchaseCreditNote
+	}
+	e.InvoiceNumber = cii.Id
+	e.Currency = cii.Settlement.Currency
+
+	e.IssueDate, err = time.Parse("20060102", strings.TrimSpace(cii.IssueDate))
+	if err != nil {
+		return fmt.Errorf("parsing issue date: %w", err)
+	}
+	if cii.Settlement.DueDate != "" {
+		e.DueDate, err = time.Parse("20060102", strings.TrimSpace(cii.Settlement.DueDate))
+		if err != nil {
+			return fmt.Errorf("parsing due date: %w", err)
+		}
+	}
+
+	e.Supplier = EInvoiceParty{
+		Name:         cii.Seller.Name,
+		Street:       cii.Seller.Address.Street,
+		Zip:          cii.Seller.Address.Zip,
+		City:         cii.Seller.Address.City,
+		CountryCode:  cii.Seller.Address.CountryCode,
+		EmailAddress: cii.Seller.EmailAddress,
+	}
+	for _, registration := range cii.Seller.TaxRegistrations {
+		switch registration.Scheme {
+		case "VA":
+			e.Supplier.VatRegistrationId = registration.Value
+		case "FC":
+			e.Supplier.TaxNumber = registration.Value
+		}
+	}
+
+	for _, line := range cii.Lines {
+		e.Lines = append(e.Lines, EInvoiceLine{
+			Name:           line.Name,
+			Quantity:       line.Quantity.Value,
+			UnitCode:       line.Quantity.UnitCode,
+			NetAmount:      line.NetAmount,
+			TaxRatePercent: line.TaxRate,
+		})
+	}
+
+	for _, tax := range cii.Settlement.Taxes {
+		e.TaxAmounts = append(e.TaxAmounts, EInvoiceTaxAmount{
+			TaxRatePercent: tax.TaxRate,
+			NetAmount:      tax.NetAmount,
+			TaxAmount:      tax.TaxAmount,
+		})
+	}
+
+	e.TotalNetAmount = cii.Settlement.Totals.NetAmount
+	e.TotalTaxAmount = amountIn(cii.Settlement.Totals.TaxAmount, e.Currency)
+	e.TotalGrossAmount = cii.Settlement.Totals.GrossAmount
+
+	return nil
+}
+
+type ublLine struct {
+	Quantity    eInvoiceQuantity `xml:"InvoicedQuantity"`
+	CreditedQty eInvoiceQuantity `xml:"CreditedQuantity"`
+	NetAmount   float64          `xml:"LineExtensionAmount"`
+	Name        string           `xml:"Item>Name"`
+	TaxRate     float64          `xml:"Item>ClassifiedTaxCategory>Percent"`
+}
+
+// ublInvoice is the part of the OASIS UBL invoice and credit note used for vouchers
+type ublInvoice struct {
+	XMLName        xml.Name
+	Id             string `xml:"ID"`
+	IssueDate      string `xml:"IssueDate"`
+	DueDate        string `xml:"DueDate"`
+	PaymentDueDate string `xml:"PaymentMeans>PaymentDueDate"`
+	Currency       string `xml:"DocumentCurrencyCode"`
+	Supplier       struct {
+		Name         string `xml:"PartyName>Name"`
+		LegalName    string `xml:"PartyLegalEntity>RegistrationName"`
+		EmailAddress string `xml:"Contact>ElectronicMail"`
+		Address      struct {
+			Street      string `xml:"StreetName"`
+			Zip         string `xml:"PostalZone"`
+			City        string `xml:"CityName"`
+			CountryCode string `xml:"Country>IdentificationCode"`
+		} `xml:"PostalAddress"`
+		TaxSchemes []struct {
+			CompanyId string `xml:"CompanyID"`
+			Scheme    string `xml:"TaxScheme>ID"`
+		} `xml:"PartyTaxScheme"`
+	} `xml:"AccountingSupplierParty>Party"`
+	TaxTotals []struct {
+		TaxAmount eInvoiceAmount `xml:"TaxAmount"`
+		Subtotals []struct {
+			NetAmount float64 `xml:"TaxableAmount"`
+			TaxAmount float64 `xml:"TaxAmount"`
+			TaxRate   float64 `xml:"TaxCategory>Percent"`
+		} `xml:"TaxSubtotal"`
+	} `xml:"TaxTotal"`
+	Totals struct {
+		NetAmount   float64 `xml:"TaxExclusiveAmount"`
+		GrossAmount float64 `xml:"TaxInclusiveAmount"`
+	} `xml:"LegalMonetaryTotal"`
+	InvoiceLines    []ublLine `xml:"InvoiceLine"`
+	CreditNoteLines []ublLine `xml:"CreditNoteLine"`
+}
+
+func (e *EInvoice) parseUBL(document []byte) error {
+	var ubl ublInvoice
+	err := xml.Unmarshal(document, &ubl)
+	if err != nil {
+		return err
+	}
+
+	e.Format = EInvoiceFormatUBL
+	e.Type = VoucherTypePurchaseInvoice
+	if ubl.XMLName.Local == "CreditNote" {
+		e.Type = VoucherTypePurchaseCreditNote
+	}
+	e.InvoiceNumber = ubl.Id
+	e.Currency = ubl.Currency
+
+	e.IssueDate, err = time.Parse("2006-01-02", strings.TrimSpace(ubl.IssueDate))
+	if err != nil {
+		return fmt.Errorf("parsing issue date: %w", err)
+	}
+	dueDate := ubl.DueDate
+	if dueDate == "" {
+		dueDate = ubl.PaymentDueDate
+	}
+	if dueDate != "" {
+		e.DueDate, err = time.Parse("2006-01-02", strings.TrimSpace(dueDate))
+		if err != nil {
+			return fmt.Errorf("parsing due date: %w", err)
+		}
+	}
+
+	e.Supplier = EInvoiceParty{
+		Name:         ubl.Supplier.LegalName,
+		Street:       ubl.Supplier.Address.Street,
+		Zip:          ubl.Supplier.Address.Zip,
+		City:         ubl.Supplier.Address.City,
+		CountryCode:  ubl.Supplier.Address.CountryCode,
+		EmailAddress: ubl.Supplier.EmailAddress,
+	}
+	if e.Supplier.Name == "" {
+		e.Supplier.Name = ubl.Supplier.Name
+	}
+	for _, scheme := range ubl.Supplier.TaxSchemes {
+		if scheme.Scheme == "VAT" {
+			e.Supplier.VatRegistrationId = scheme.CompanyId
+		} else {
+			e.Supplier.TaxNumber = scheme.CompanyId
+		}
+	}
+
+	for _, line := range append(ubl.InvoiceLines, ubl.CreditNoteLines...) {
+		quantity := line.Quantity
+		if quantity.UnitCode == "" {
+			quantity = line.CreditedQty
+		}
+		e.Lines = append(e.Lines, EInvoiceLine{
+			Name:           line.Name,
+			Quantity:       quantity.Value,
+			UnitCode:       quantity.UnitCode,
+			NetAmount:      line.NetAmount,
+			TaxRatePercent: line.TaxRate,
+		})
+	}
+
+	// the tax total in the accounting currency has no subtotals
+	for _, total := range ubl.TaxTotals {
+		if total.TaxAmount.Currency != "" && total.TaxAmount.Currency != e.Currency {
+			continue
+		}
+		e.TotalTaxAmount = total.TaxAmount.Value
+		for _, subtotal := range total.Subtotals {
+			e.TaxAmounts = append(e.TaxAmounts, EInvoiceTaxAmount{
+				TaxRatePercent: subtotal.TaxRate,
+				NetAmount:      subtotal.NetAmount,
+				TaxAmount:      subtotal.TaxAmount,
+			})
+		}
+	}
+
+	e.TotalNetAmount = ubl.Totals.NetAmount
+	e.TotalGrossAmount = ubl.Totals.GrossAmount
+
+	return nil
+}
+
+// pdfEmbeddedInvoice is to find the XML invoice in the embedded files of a
+// hybrid PDF, the streams are either uncompressed or flate encoded
+func pdfEmbeddedInvoice(data []byte) ([]byte, error) {
+	keyword := []byte("stream")
+
+	for offset := 0; ; {
+		index := bytes.Index(data[offset:], keyword)
+		if index < 0 {
+			break
+		}
+		start := offset + index
+		offset = start + len(keyword)
+
+		if bytes.HasSuffix(data[:start], []byte("end")) {
+			continue
+		}
+
+		// the stream dictionary is between the object header and the keyword
+		header := bytes.LastIndex(data[:start], []byte("obj"))
+		if header < 0 {
+			continue
+		}
+		dictionary := data[header:start]
+		if !bytes.Contains(dictionary, []byte("/EmbeddedFile")) {
+			continue
+		}
+
+		content, ok := pdfStreamContent(data[offset:], dictionary)
+		if !ok {
+			continue
+		}
+		if _, err := eInvoiceFormat(content); err == nil {
+			return content, nil
+		}
+	}
+
+	return nil, errors.New("e-invoice: no XML invoice embedded in PDF")
+}
+
+// pdfStreamContent is to read and decode a stream, data starts after the
+// stream keyword
+func pdfStreamContent(data []byte, dictionary []byte) ([]byte, bool) {
+	switch {
+	case bytes.HasPrefix(data, []byte("\r\n")):
+		data = data[2:]
+	case bytes.HasPrefix(data, []byte("\n")):
+		data = data[1:]
+	}
+
+	// indirect lengths are not resolved, the stream ends at "endstream" then
+	var raw []byte
+	if match := pdfLength.FindSubmatch(dictionary); match != nil && len(match[2]) == 0 {
+		length, err := strconv.Atoi(string(match[1]))
+		if err != nil || length > len(data) {
+			return nil, false
+		}
+		raw = data[:length]
+	} else {
+		end := bytes.Index(data, []byte("endstream"))
+		if end < 0 {
+			return nil, false
+		}
+		raw = bytes.TrimRight(data[:end], "\r\n")
+	}
+
+	match := pdfFilter.FindSubmatch(dictionary)
+	if match == nil {
+		return raw, true
+	}
+	if string(match[1]) != "FlateDecode" {
+		return nil, false
+	}
+
+	reader, err := zlib.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, false
+	}
+	defer reader.Close()
+
+	content, err := io.ReadAll(io.LimitReader(reader, 4*FileMaxSize))
+	if err != nil {
+		return nil, false
+	}
+
+	return content, true
+}
@@ -0,0 +1,352 @@
+package golexoffice_test
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hostwithquantum/golexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+const ciiInvoice = `<?xml version="1.0" encoding="UTF-8"?>
+<rsm:CrossIndustryInvoice xmlns:rsm="urn:un:unece:uncefact:data:standard:CrossIndustryInvoice:100"
+	xmlns:ram="urn:un:unece:uncefact:data:standard:ReusableAggregateBusinessInformationEntity:100"
+	xmlns:udt="urn:un:unece:uncefact:data:standard:UnqualifiedDataType:100">
+	<rsm:ExchangedDocument>
+		<ram:ID>RE-4711</ram:ID>
+		<ram:TypeCode>380</ram:TypeCode>
+		<ram:IssueDateTime><udt:DateTimeString format="102">20230614</udt:DateTimeString></ram:IssueDateTime>
+	</rsm:ExchangedDocument>
+	<rsm:SupplyChainTradeTransaction>
+		<ram:IncludedSupplyChainTradeLineItem>
+			<ram:SpecifiedTradeProduct><ram:Name>Hosting</ram:Name></ram:SpecifiedTradeProduct>
+			<ram:SpecifiedLineTradeDelivery><ram:BilledQuantity unitCode="MON">1</ram:BilledQuantity></ram:SpecifiedLineTradeDelivery>
+			<ram:SpecifiedLineTradeSettlement>
+				<ram:ApplicableTradeTax><ram:RateApplicablePercent>19</ram:RateApplicablePercent></ram:ApplicableTradeTax>
+				<ram:SpecifiedTradeSettlementLineMonetarySummation><ram:LineTotalAmount>100.00</ram:LineTotalAmount></ram:SpecifiedTradeSettlementLineMonetarySummation>
+			</ram:SpecifiedLineTradeSettlement>
+		</ram:IncludedSupplyChainTradeLineItem>
+		<ram:IncludedSupplyChainTradeLineItem>
+			<ram:SpecifiedTradeProduct><ram:Name>Handbuch</ram:Name></ram:SpecifiedTradeProduct>
+			<ram:SpecifiedLineTradeDelivery><ram:BilledQuantity unitCode="H87">2</ram:BilledQuantity></ram:SpecifiedLineTradeDelivery>
+			<ram:SpecifiedLineTradeSettlement>
+				<ram:ApplicableTradeTax><ram:RateApplicablePercent>7</ram:RateApplicablePercent></ram:ApplicableTradeTax>
+				<ram:SpecifiedTradeSettlementLineMonetarySummation><ram:LineTotalAmount>20.00</ram:LineTotalAmount></ram:SpecifiedTradeSettlementLineMonetarySummation>
+			</ram:SpecifiedLineTradeSettlement>
+		</ram:IncludedSupplyChainTradeLineItem>
+		<ram:ApplicableHeaderTradeAgreement>
+			<ram:BuyerReference>04011000-1234512345-06</ram:BuyerReference>
+			<ram:SellerTradeParty>
+				<ram:Name>Lieferant GmbH</ram:Name>
+				<ram:PostalTradeAddress>
+					<ram:PostcodeCode>10115</ram:PostcodeCode>
+					<ram:LineOne>Musterstraße 1</ram:LineOne>
+					<ram:CityName>Berlin</ram:CityName>
+					<ram:CountryID>DE</ram:CountryID>
+				</ram:PostalTradeAddress>
+				<ram:URIUniversalCommunication><ram:URIID schemeID="EM">rechnung@lieferant.de</ram:URIID></ram:URIUniversalCommunication>
+				<ram:SpecifiedTaxRegistration><ram:ID schemeID="VA">DE123456789</ram:ID></ram:SpecifiedTaxRegistration>
+				<ram:SpecifiedTaxRegistration><ram:ID schemeID="FC">30/123/45678</ram:ID></ram:SpecifiedTaxRegistration>
+			</ram:SellerTradeParty>
+		</ram:ApplicableHeaderTradeAgreement>
+		<ram:ApplicableHeaderTradeSettlement>
+			<ram:InvoiceCurrencyCode>EUR</ram:InvoiceCurrencyCode>
+			<ram:ApplicableTradeTax>
+				<ram:CalculatedAmount>19.00</ram:CalculatedAmount>
+				<ram:TypeCode>VAT</ram:TypeCode>
+				<ram:BasisAmount>100.00</ram:BasisAmount>
+				<ram:CategoryCode>S</ram:CategoryCode>
+				<ram:RateApplicablePercent>19</ram:RateApplicablePercent>
+			</ram:ApplicableTradeTax>
+			<ram:ApplicableTradeTax>
+				<ram:CalculatedAmount>1.40</ram:CalculatedAmount>
+				<ram:TypeCode>VAT</ram:TypeCode>
+				<ram:BasisAmount>20.00</ram:BasisAmount>
+				<ram:CategoryCode>S</ram:CategoryCode>
+				<ram:RateApplicablePercent>7</ram:RateApplicablePercent>
+			</ram:ApplicableTradeTax>
+			<ram:SpecifiedTradePaymentTerms>
+				<ram:DueDateDateTime><udt:DateTimeString format="102">20230714</udt:DateTimeString></ram:DueDateDateTime>
+			</ram:SpecifiedTradePaymentTerms>
+			<ram:SpecifiedTradeSettlementHeaderMonetarySummation>
+				<ram:LineTotalAmount>120.00</ram:LineTotalAmount>
+				<ram:TaxBasisTotalAmount>120.00</ram:TaxBasisTotalAmount>
+				<ram:TaxTotalAmount currencyID="EUR">20.40</ram:TaxTotalAmount>
+				<ram:GrandTotalAmount>140.40</ram:GrandTotalAmount>
+				<ram:DuePayableAmount>140.40</ram:DuePayableAmount>
+			</ram:SpecifiedTradeSettlementHeaderMonetarySummation>
+		</ram:ApplicableHeaderTradeSettlement>
+	</rsm:SupplyChainTradeTransaction>
+</rsm:CrossIndustryInvoice>`
+
+const zugferd1Invoice = `<?xml version="1.0" encoding="UTF-8"?>
+<rsm:CrossIndustryDocument xmlns:rsm="urn:ferd:CrossIndustryDocument:invoice:1p0"
+	xmlns:ram="urn:un:unece:uncefact:data:standard:ReusableAggregateBusinessInformationEntity:12"
+	xmlns:udt="urn:un:unece:uncefact:data:standard:UnqualifiedDataType:15">
+	<rsm:HeaderExchangedDocument>
+		<ram:ID>RE-1001</ram:ID>
+		<ram:Name>RECHNUNG</ram:Name>
+		<ram:TypeCode>380</ram:TypeCode>
+		<ram:IssueDateTime><udt:DateTimeString format="102">20130305</udt:DateTimeString></ram:IssueDateTime>
+	</rsm:HeaderExchangedDocument>
+	<rsm:SpecifiedSupplyChainTradeTransaction>
+		<ram:ApplicableSupplyChainTradeAgreement>
+			<ram:SellerTradeParty>
+				<ram:Name>Lieferant GmbH</ram:Name>
+				<ram:PostalTradeAddress>
+					<ram:PostcodeCode>80333</ram:PostcodeCode>
+					<ram:LineOne>Lieferantenstraße 20</ram:LineOne>
+					<ram:CityName>München</ram:CityName>
+					<ram:CountryID>DE</ram:CountryID>
+				</ram:PostalTradeAddress>
+				<ram:SpecifiedTaxRegistration><ram:ID schemeID="VA">DE123456789</ram:ID></ram:SpecifiedTaxRegistration>
+			</ram:SellerTradeParty>
+		</ram:ApplicableSupplyChainTradeAgreement>
+		<ram:ApplicableSupplyChainTradeSettlement>
+			<ram:InvoiceCurrencyCode>EUR</ram:InvoiceCurrencyCode>
+			<ram:ApplicableTradeTax>
+				<ram:CalculatedAmount currencyID="EUR">37.62</ram:CalculatedAmount>
+				<ram:TypeCode>VAT</ram:TypeCode>
+				<ram:BasisAmount currencyID="EUR">198.00</ram:BasisAmount>
+				<ram:CategoryCode>S</ram:CategoryCode>
+				<ram:ApplicablePercent>19.00</ram:ApplicablePercent>
+			</ram:ApplicableTradeTax>
+			<ram:SpecifiedTradePaymentTerms>
+				<ram:DueDateDateTime><udt:DateTimeString format="102">20130413</udt:DateTimeString></ram:DueDateDateTime>
+			</ram:SpecifiedTradePaymentTerms>
+			<ram:SpecifiedTradeSettlementMonetarySummation>
+				<ram:LineTotalAmount currencyID="EUR">198.00</ram:LineTotalAmount>
+				<ram:TaxBasisTotalAmount currencyID="EUR">198.00</ram:TaxBasisTotalAmount>
+				<ram:TaxTotalAmount currencyID="EUR">37.62</ram:TaxTotalAmount>
+				<ram:GrandTotalAmount currencyID="EUR">235.62</ram:GrandTotalAmount>
+			</ram:SpecifiedTradeSettlementMonetarySummation>
+		</ram:ApplicableSupplyChainTradeSettlement>
+		<ram:IncludedSupplyChainTradeLineItem>
+			<ram:SpecifiedSupplyChainTradeDelivery><ram:BilledQuantity unitCode="MTK">20.0000</ram:BilledQuantity></ram:SpecifiedSupplyChainTradeDelivery>
+			<ram:SpecifiedSupplyChainTradeSettlement>
+				<ram:ApplicableTradeTax><ram:ApplicablePercent>19.00</ram:ApplicablePercent></ram:ApplicableTradeTax>
+				<ram:SpecifiedTradeSettlementMonetarySummation><ram:LineTotalAmount currencyID="EUR">198.00</ram:LineTotalAmount></ram:SpecifiedTradeSettlementMonetarySummation>
+			</ram:SpecifiedSupplyChainTradeSettlement>
+			<ram:SpecifiedTradeProduct><ram:Name>Kunstrasen</ram:Name></ram:SpecifiedTradeProduct>
+		</ram:IncludedSupplyChainTradeLineItem>
+	</rsm:SpecifiedSupplyChainTradeTransaction>
+</rsm:CrossIndustryDocument>`
+
+const ublCreditNote = `<?xml version="1.0" encoding="UTF-8"?>
+<CreditNote xmlns="urn:oasis:names:specification:ubl:schema:xsd:CreditNote-2"
+	xmlns:cac="urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2"
+	xmlns:cbc="urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2">
+	<cbc:ID>GS-0815</cbc:ID>
+	<cbc:IssueDate>2023-06-20</cbc:IssueDate>
+	<cbc:CreditNoteTypeCode>381</cbc:CreditNoteTypeCode>
+	<cbc:DocumentCurrencyCode>EUR</cbc:DocumentCurrencyCode>
+	<cac:AccountingSupplierParty>
+		<cac:Party>
+			<cac:PartyName><cbc:Name>Lieferant</cbc:Name></cac:PartyName>
+			<cac:PostalAddress>
+				<cbc:StreetName>Musterstraße 1</cbc:StreetName>
+				<cbc:CityName>Wien</cbc:CityName>
+				<cbc:PostalZone>1010</cbc:PostalZone>
+				<cac:Country><cbc:IdentificationCode>AT</cbc:IdentificationCode></cac:Country>
+			</cac:PostalAddress>
+			<cac:PartyTaxScheme>
+				<cbc:CompanyID>ATU12345678</cbc:CompanyID>
+				<cac:TaxScheme><cbc:ID>VAT</cbc:ID></cac:TaxScheme>
+			</cac:PartyTaxScheme>
+			<cac:PartyLegalEntity><cbc:RegistrationName>Lieferant GmbH</cbc:RegistrationName></cac:PartyLegalEntity>
+		</cac:Party>
+	</cac:AccountingSupplierParty>
+	<cac:PaymentMeans>
+		<cbc:PaymentMeansCode>58</cbc:PaymentMeansCode>
+		<cbc:PaymentDueDate>2023-07-20</cbc:PaymentDueDate>
+	</cac:PaymentMeans>
+	<cac:TaxTotal>
+		<cbc:TaxAmount currencyID="EUR">3.80</cbc:TaxAmount>
+		<cac:TaxSubtotal>
+			<cbc:TaxableAmount currencyID="EUR">20.00</cbc:TaxableAmount>
+			<cbc:TaxAmount currencyID="EUR">3.80</cbc:TaxAmount>
+			<cac:TaxCategory><cbc:ID>S</cbc:ID><cbc:Percent>19</cbc:Percent></cac:TaxCategory>
+		</cac:TaxSubtotal>
+	</cac:TaxTotal>
+	<cac:TaxTotal>
+		<cbc:TaxAmount currencyID="USD">4.10</cbc:TaxAmount>
+	</cac:TaxTotal>
+	<cac:LegalMonetaryTotal>
+		<cbc:LineExtensionAmount currencyID="EUR">20.00</cbc:LineExtensionAmount>
+		<cbc:TaxExclusiveAmount currencyID="EUR">20.00</cbc:TaxExclusiveAmount>
+		<cbc:TaxInclusiveAmount currencyID="EUR">23.80</cbc:TaxInclusiveAmount>
+		<cbc:PayableAmount currencyID="EUR">23.80</cbc:PayableAmount>
+	</cac:LegalMonetaryTotal>
+	<cac:CreditNoteLine>
+		<cbc:ID>1</cbc:ID>
+		<cbc:CreditedQuantity unitCode="HUR">2</cbc:CreditedQuantity>
+		<cbc:LineExtensionAmount currencyID="EUR">20.00</cbc:LineExtensionAmount>
+		<cac:Item>
+			<cbc:Name>Support</cbc:Name>
+			<cac:ClassifiedTaxCategory><cbc:ID>S</cbc:ID><cbc:Percent>19</cbc:Percent></cac:ClassifiedTaxCategory>
+		</cac:Item>
+	</cac:CreditNoteLine>
+</CreditNote>`
+
+func TestParseEInvoice(t *testing.T) {
+	t.Run("cii", func(t *testing.T) {
+		invoice, err := golexoffice.ParseEInvoice(strings.NewReader(ciiInvoice), "RE-4711.xml")
+		assert.NoError(t, err)
+
+		assert.Equal(t, golexoffice.EInvoiceFormatCII, invoice.Format)
+		assert.Equal(t, golexoffice.VoucherTypePurchaseInvoice, invoice.Type)
+		assert.Equal(t, "RE-4711", invoice.InvoiceNumber)
+		assert.Equal(t, "2023-07-14", invoice.DueDate.Format("2006-01-02"))
+		assert.Equal(t, golexoffice.EInvoiceParty{
+			Name:              "Lieferant GmbH",
+			VatRegistrationId: "DE123456789",
+			TaxNumber:         "30/123/45678",
+			Street:            "Musterstraße 1",
+			Zip:               "10115",
+			City:              "Berlin",
+			CountryCode:       "DE",
+			EmailAddress:      "rechnung@lieferant.de",
+		}, invoice.Supplier)
+		assert.Equal(t, []golexoffice.EInvoiceLine{
+			{Name: "Hosting", Quantity: 1, UnitCode: "MON", NetAmount: 100, TaxRatePercent: 19},
+			{Name: "Handbuch", Quantity: 2, UnitCode: "H87", NetAmount: 20, TaxRatePercent: 7},
+		}, invoice.Lines)
+		assert.Equal(t, 120.0, invoice.TotalNetAmount)
+		assert.Equal(t, 20.4, invoice.TotalTaxAmount)
+		assert.Equal(t, 140.4, invoice.TotalGrossAmount)
+
+		voucher, err := invoice.VoucherBody("16d04a28-8aaa-4c3a-ab61-c1e1f7d8ba0a", "")
+		assert.NoError(t, err)
+		assert.Equal(t, "2023-06-14", voucher.VoucherDate)
+		assert.Equal(t, "2023-07-14", voucher.DueDate)
+		assert.True(t, voucher.UseCollectiveContact)
+		assert.Equal(t, []golexoffice.VoucherBodyItems{
+			{Amount: 100, TaxAmount: 19, TaxRatePercent: 19, CategoryId: "16d04a28-8aaa-4c3a-ab61-c1e1f7d8ba0a"},
+			{Amount: 20, TaxAmount: 1.4, TaxRatePercent: 7, CategoryId: "16d04a28-8aaa-4c3a-ab61-c1e1f7d8ba0a"},
+		}, voucher.VoucherItems)
+
+		contact := invoice.ContactBody()
+		assert.NotNil(t, contact.Roles.Vendor)
+		assert.Equal(t, "Lieferant GmbH", contact.Company.Name)
+		assert.Equal(t, "Berlin", contact.Addresses.Billing[0].City)
+		assert.Equal(t, []string{"rechnung@lieferant.de"}, contact.EmailAddresses.Business)
+	})
+
+	t.Run("ubl", func(t *testing.T) {
+		invoice, err := golexoffice.ParseEInvoice(strings.NewReader(ublCreditNote), "GS-0815.xml")
+		assert.NoError(t, err)
+
+		assert.Equal(t, golexoffice.EInvoiceFormatUBL, invoice.Format)
+		assert.Equal(t, golexoffice.VoucherTypePurchaseCreditNote, invoice.Type)
+		assert.Equal(t, "GS-0815", invoice.InvoiceNumber)
+		assert.Equal(t, "2023-07-20", invoice.DueDate.Format("2006-01-02"))
+		assert.Equal(t, "Lieferant GmbH", invoice.Supplier.Name)
+		assert.Equal(t, "ATU12345678", invoice.Supplier.VatRegistrationId)
+		assert.Equal(t, "AT", invoice.Supplier.CountryCode)
+		assert.Equal(t, []golexoffice.EInvoiceLine{
+			{Name: "Support", Quantity: 2, UnitCode: "HUR", NetAmount: 20, TaxRatePercent: 19},
+		}, invoice.Lines)
+		assert.Equal(t, []golexoffice.EInvoiceTaxAmount{{TaxRatePercent: 19, NetAmount: 20, TaxAmount: 3.8}}, invoice.TaxAmounts)
+		assert.Equal(t, 3.8, invoice.TotalTaxAmount)
+		assert.Equal(t, 23.8, invoice.TotalGrossAmount)
+
+		voucher, err := invoice.VoucherBody("8f8664a8-fd86-11e1-a21f-0800200c9a66", "e9066f04-8cc7-4616-93f8-ac9ecc8479c8")
+		assert.NoError(t, err)
+		assert.False(t, voucher.UseCollectiveContact)
+		assert.Equal(t, "e9066f04-8cc7-4616-93f8-ac9ecc8479c8", voucher.ContactId)
+	})
+
+	t.Run("zugferd 1.0", func(t *testing.T) {
+		invoice, err := golexoffice.ParseEInvoice(strings.NewReader(zugferd1Invoice), "RE-1001.xml")
+		assert.NoError(t, err)
+
+		assert.Equal(t, golexoffice.EInvoiceFormatCII, invoice.Format)
+		assert.Equal(t, golexoffice.VoucherTypePurchaseInvoice, invoice.Type)
+		assert.Equal(t, "RE-1001", invoice.InvoiceNumber)
+		assert.Equal(t, "2013-04-13", invoice.DueDate.Format("2006-01-02"))
+		assert.Equal(t, "Lieferant GmbH", invoice.Supplier.Name)
+		assert.Equal(t, "DE123456789", invoice.Supplier.VatRegistrationId)
+		assert.Equal(t, []golexoffice.EInvoiceLine{
+			{Name: "Kunstrasen", Quantity: 20, UnitCode: "MTK", NetAmount: 198, TaxRatePercent: 19},
+		}, invoice.Lines)
+		assert.Equal(t, 198.0, invoice.TotalNetAmount)
+		assert.Equal(t, 37.62, invoice.TotalTaxAmount)
+		assert.Equal(t, 235.62, invoice.TotalGrossAmount)
+
+		voucher, err := invoice.VoucherBody("16d04a28-8aaa-4c3a-ab61-c1e1f7d8ba0a", "")
+		assert.NoError(t, err)
+		assert.Equal(t, "2013-03-05", voucher.VoucherDate)
+		assert.Equal(t, []golexoffice.VoucherBodyItems{
+			{Amount: 198, TaxAmount: 37.62, TaxRatePercent: 19, CategoryId: "16d04a28-8aaa-4c3a-ab61-c1e1f7d8ba0a"},
+		}, voucher.VoucherItems)
+	})
+
+	t.Run("other currency", func(t *testing.T) {
+		invoice, err := golexoffice.ParseEInvoice(strings.NewReader(strings.ReplaceAll(ciiInvoice, "EUR", "CHF")), "RE-4711.xml")
+		assert.NoError(t, err)
+		assert.Equal(t, "CHF", invoice.Currency)
+
+		_, err = invoice.VoucherBody("16d04a28-8aaa-4c3a-ab61-c1e1f7d8ba0a", "")
+		assert.ErrorContains(t, err, "RE-4711 is in CHF, vouchers are in EUR only")
+	})
+
+	t.Run("hybrid pdf", func(t *testing.T) {
+		invoice, err := golexoffice.ParseEInvoice(bytes.NewReader(hybridPDF(t, ciiInvoice)), "RE-4711.pdf")
+		assert.NoError(t, err)
+		assert.Equal(t, golexoffice.EInvoiceFormatCII, invoice.Format)
+		assert.Equal(t, "RE-4711", invoice.InvoiceNumber)
+
+		upload := invoice.FileUpload()
+		assert.Equal(t, "application/pdf", upload.ContentType)
+		assert.Equal(t, "RE-4711.pdf", upload.FileName)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		_, err := golexoffice.ParseEInvoice(strings.NewReader(`<?xml version="1.0"?><Order/>`), "order.xml")
+		assert.ErrorContains(t, err, "unsupported root element Order")
+
+		_, err = golexoffice.ParseEInvoice(strings.NewReader("%PDF-1.7\n%%EOF"), "scan.pdf")
+		assert.ErrorContains(t, err, "no XML invoice embedded in PDF")
+	})
+}
+
+func TestCreateVoucherFromEInvoice(t *testing.T) {
+	server := vouchersMock()
+	defer server.Close()
+
+	lexOffice := golexoffice.NewConfig("token", nil)
+	lexOffice.SetBaseUrl(server.URL)
+
+	invoice, err := golexoffice.ParseEInvoice(bytes.NewReader(hybridPDF(t, ciiInvoice)), "RE-4711.pdf")
+	assert.NoError(t, err)
+
+	body, err := invoice.VoucherBody("16d04a28-8aaa-4c3a-ab61-c1e1f7d8ba0a", "")
+	assert.NoError(t, err)
+
+	voucher, err := lexOffice.CreateVoucherFromEInvoice(invoice, body)
+	assert.NoError(t, err)
+	assert.Equal(t, "e9cb8e0b-9a4f-4b93-8e9c-4e5b0c8e7b3a", voucher.Id)
+}
+
+// hybridPDF is to build a minimal PDF with the invoice as flate encoded
+// embedded file, like ZUGFeRD does
+func hybridPDF(t *testing.T, invoice string) []byte {
+	var compressed bytes.Buffer
+	writer := zlib.NewWriter(&compressed)
+	_, err := writer.Write([]byte(invoice))
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Close())
+
+	var pdf bytes.Buffer
+	pdf.WriteString("%PDF-1.7\n")
+	pdf.WriteString("1 0 obj\n<< /Type /Catalog /Names << /EmbeddedFiles << /Names [(factur-x.xml) 2 0 R] >> >> >>\nendobj\n")
+	pdf.WriteString("2 0 obj\n<< /Type /Filespec /F (factur-x.xml) /EF << /F 3 0 R >> /AFRelationship /Alternative >>\nendobj\n")
+	fmt.Fprintf(&pdf, "3 0 obj\n<< /Type /EmbeddedFile /Subtype /text#2Fxml /Filter /FlateDecode /Length %d >>\nstream\n", compressed.Len())
+	pdf.Write(compressed.Bytes())
+	pdf.WriteString("\nendstream\nendobj\n%%EOF\n")
+
+	return pdf.Bytes()
+}
@@ -1,5 +1,11 @@
 package golexoffice
 
+import "errors"
+
+// ErrVersionConflict is returned when lexoffice rejects an update (PUT), because
+// the resource was changed in the meantime (optimistic locking)
+var ErrVersionConflict = errors.New("version conflict")
+
 // source: https://developers.lexoffice.io/docs/#error-codes-legacy-error-response
 // files, profile, contacts
 //
@@ -20,7 +26,7 @@ type LegacyErrorResponse struct {
 }
 
 // source: https://developers.lexoffice.io/docs/#error-codes-regular-error-response
-// event-subscription, invoices
+// event-subscription, invoices, transaction-assignment-hint
 //
 //	{
 //		"timestamp": "2017-05-11T17:12:31.233+02:00",
@@ -0,0 +1,86 @@
+package golexoffice
+
+import "context"
+
+// EventResolver is to hand webhook callbacks the resource of the event,
+// instead of its id only
+//
+// The resources are requested with the Config, at most concurrency at once.
+// The resolver enables the request interval of the Config (see
+// SetRequestInterval), so share the Config between resolvers (and other
+// requests) of a token to stay within the rate limit of lexoffice together.
+// Register deleted events with WebhookHandler.On, because their resource
+// can't be requested.
+type EventResolver struct {
+	config  *Config
+	handler *WebhookHandler
+	slots   chan struct{}
+}
+
+// NewEventResolver is to create a resolver which registers its callbacks on
+// the handler
+func NewEventResolver(c *Config, handler *WebhookHandler, concurrency int) *EventResolver {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	c.limiter.setDefault(defaultRequestInterval)
+
+	return &EventResolver{
+		config:  c,
+		handler: handler,
+		slots:   make(chan struct{}, concurrency),
+	}
+}
+
+// OnInvoice is to register a callback which gets the invoice of the event
+func (e *EventResolver) OnInvoice(eventType EventType, fn func(ctx context.Context, event WebhookEvent, invoice InvoiceBody) error) {
+	resolve(e, eventType, e.config.Invoice, fn)
+}
+
+// OnContact is to register a callback which gets the contact of the event
+func (e *EventResolver) OnContact(eventType EventType, fn func(ctx context.Context, event WebhookEvent, contact ContactsReturnContent) error) {
+	resolve(e, eventType, e.config.Contact, fn)
+}
+
+// OnCreditNote is to register a callback which gets the credit note of the event
+func (e *EventResolver) OnCreditNote(eventType EventType, fn func(ctx context.Context, event WebhookEvent, creditNote CreditNoteBody) error) {
+	resolve(e, eventType, e.config.CreditNote, fn)
+}
+
+// OnPayment is to register a callback which gets the payment of the event,
+// the resource of payment events is the voucher which was paid
+func (e *EventResolver) OnPayment(eventType EventType, fn func(ctx context.Context, event WebhookEvent, payment PaymentReturn) error) {
+	resolve(e, eventType, e.config.Payment, fn)
+}
+
+func resolve[T any](e *EventResolver, eventType EventType, get func(id string) (T, error), fn func(ctx context.Context, event WebhookEvent, resource T) error) {
+	e.handler.On(eventType, func(ctx context.Context, event WebhookEvent) error {
+		err := e.acquire(ctx)
+		if err != nil {
+			return err
+		}
+
+		resource, err := get(event.ResourceId)
+		e.release()
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, event, resource)
+	})
+}
+
+// acquire is to wait for a free slot
+func (e *EventResolver) acquire(ctx context.Context) error {
+	select {
+	case e.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (e *EventResolver) release() {
+	<-e.slots
+}
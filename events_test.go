@@ -0,0 +1,90 @@
+package golexoffice_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hostwithquantum/golexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventResolver(t *testing.T) {
+	contacts := lexOfficeMock()
+	defer contacts.Close()
+	payments := paymentsMock()
+	defer payments.Close()
+
+	privateKey, publicKey := webhookKeys(t)
+	handler, err := golexoffice.NewWebhookHandlerWithKey(publicKey)
+	assert.NoError(t, err)
+
+	// both resolvers share the Config (and its rate limit) of the token
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		target := contacts.URL
+		if strings.HasPrefix(r.URL.Path, "/v1/payments/") {
+			target = payments.URL
+		}
+		proxy, _ := url.Parse(target)
+		httputil.NewSingleHostReverseProxy(proxy).ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	lexOffice := golexoffice.NewConfig("token", nil)
+	lexOffice.SetBaseUrl(server.URL)
+
+	var mu sync.Mutex
+	var names []string
+	golexoffice.NewEventResolver(lexOffice, handler, 2).OnContact(golexoffice.EventTypeContactChanged,
+		func(_ context.Context, event golexoffice.WebhookEvent, contact golexoffice.ContactsReturnContent) error {
+			mu.Lock()
+			defer mu.Unlock()
+			if contact.Company != nil {
+				names = append(names, contact.Company.Name)
+			} else {
+				names = append(names, contact.Person.LastName)
+			}
+			return nil
+		})
+
+	var payment golexoffice.PaymentReturn
+	golexoffice.NewEventResolver(lexOffice, handler, 1).OnPayment(golexoffice.EventTypePaymentChanged,
+		func(_ context.Context, _ golexoffice.WebhookEvent, resolved golexoffice.PaymentReturn) error {
+			payment = resolved
+			return nil
+		})
+
+	t.Run("contact", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for _, id := range []string{"c73d5f78-847e-49d8-aa58-c6d95c5c9cb5", "e9066f04-8cc7-4616-93f8-ac9ecc8479c8"} {
+			body := webhookEvent(golexoffice.EventTypeContactChanged, id, time.Now())
+			signature := signWebhook(t, privateKey, body)
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.Equal(t, http.StatusOK, sendWebhook(handler, body, signature))
+			}()
+		}
+		wg.Wait()
+
+		assert.ElementsMatch(t, []string{"Beispiel GmbH", "Musterfrau"}, names)
+	})
+
+	t.Run("payment", func(t *testing.T) {
+		body := webhookEvent(golexoffice.EventTypePaymentChanged, "0cf8142b-6f54-4c96-9766-6f44a9a4814b", time.Now())
+		assert.Equal(t, http.StatusOK, sendWebhook(handler, body, signWebhook(t, privateKey, body)))
+		assert.True(t, payment.IsPaid())
+	})
+
+	t.Run("unknown resource", func(t *testing.T) {
+		body := webhookEvent(golexoffice.EventTypeContactChanged, "does-not-exist", time.Now())
+		assert.Equal(t, http.StatusInternalServerError, sendWebhook(handler, body, signWebhook(t, privateKey, body)))
+	})
+}
@@ -0,0 +1,236 @@
+package golexoffice
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// EventType is the type of an event lexoffice sends to a subscription
+type EventType string
+
+const (
+	EventTypeArticleCreated EventType = "article.created"
+	EventTypeArticleChanged EventType = "article.changed"
+	EventTypeArticleDeleted EventType = "article.deleted"
+
+	EventTypeContactCreated EventType = "contact.created"
+	EventTypeContactChanged EventType = "contact.changed"
+	EventTypeContactDeleted EventType = "contact.deleted"
+
+	EventTypeCreditNoteCreated       EventType = "credit-note.created"
+	EventTypeCreditNoteChanged       EventType = "credit-note.changed"
+	EventTypeCreditNoteDeleted       EventType = "credit-note.deleted"
+	EventTypeCreditNoteStatusChanged EventType = "credit-note.status.changed"
+
+	EventTypeDeliveryNoteCreated       EventType = "delivery-note.created"
+	EventTypeDeliveryNoteChanged       EventType = "delivery-note.changed"
+	EventTypeDeliveryNoteDeleted       EventType = "delivery-note.deleted"
+	EventTypeDeliveryNoteStatusChanged EventType = "delivery-note.status.changed"
+
+	EventTypeDownPaymentInvoiceCreated       EventType = "down-payment-invoice.created"
+	EventTypeDownPaymentInvoiceChanged       EventType = "down-payment-invoice.changed"
+	EventTypeDownPaymentInvoiceDeleted       EventType = "down-payment-invoice.deleted"
+	EventTypeDownPaymentInvoiceStatusChanged EventType = "down-payment-invoice.status.changed"
+
+	EventTypeDunningCreated EventType = "dunning.created"
+	EventTypeDunningChanged EventType = "dunning.changed"
+	EventTypeDunningDeleted EventType = "dunning.deleted"
+
+	EventTypeInvoiceCreated       EventType = "invoice.created"
+	EventTypeInvoiceChanged       EventType = "invoice.changed"
+	EventTypeInvoiceDeleted       EventType = "invoice.deleted"
+	EventTypeInvoiceStatusChanged EventType = "invoice.status.changed"
+
+	EventTypeOrderConfirmationCreated       EventType = "order-confirmation.created"
+	EventTypeOrderConfirmationChanged       EventType = "order-confirmation.changed"
+	EventTypeOrderConfirmationDeleted       EventType = "order-confirmation.deleted"
+	EventTypeOrderConfirmationStatusChanged EventType = "order-confirmation.status.changed"
+
+	EventTypePaymentChanged EventType = "payment.changed"
+
+	EventTypeQuotationCreated       EventType = "quotation.created"
+	EventTypeQuotationChanged       EventType = "quotation.changed"
+	EventTypeQuotationDeleted       EventType = "quotation.deleted"
+	EventTypeQuotationStatusChanged EventType = "quotation.status.changed"
+
+	EventTypeRecurringTemplateCreated EventType = "recurring-template.created"
+	EventTypeRecurringTemplateChanged EventType = "recurring-template.changed"
+	EventTypeRecurringTemplateDeleted EventType = "recurring-template.deleted"
+
+	EventTypeTokenRevoked EventType = "token.revoked"
+
+	EventTypeVoucherCreated       EventType = "voucher.created"
+	EventTypeVoucherChanged       EventType = "voucher.changed"
+	EventTypeVoucherDeleted       EventType = "voucher.deleted"
+	EventTypeVoucherStatusChanged EventType = "voucher.status.changed"
+)
+
+// EventTypes are all documented event types
+var EventTypes = []EventType{
+	EventTypeArticleCreated,
+	EventTypeArticleChanged,
+	EventTypeArticleDeleted,
+	EventTypeContactCreated,
+	EventTypeContactChanged,
+	EventTypeContactDeleted,
+	EventTypeCreditNoteCreated,
+	EventTypeCreditNoteChanged,
+	EventTypeCreditNoteDeleted,
+	EventTypeCreditNoteStatusChanged,
+	EventTypeDeliveryNoteCreated,
+	EventTypeDeliveryNoteChanged,
+	EventTypeDeliveryNoteDeleted,
+	EventTypeDeliveryNoteStatusChanged,
+	EventTypeDownPaymentInvoiceCreated,
+	EventTypeDownPaymentInvoiceChanged,
+	EventTypeDownPaymentInvoiceDeleted,
+	EventTypeDownPaymentInvoiceStatusChanged,
+	EventTypeDunningCreated,
+	EventTypeDunningChanged,
+	EventTypeDunningDeleted,
+	EventTypeInvoiceCreated,
+	EventTypeInvoiceChanged,
+	EventTypeInvoiceDeleted,
+	EventTypeInvoiceStatusChanged,
+	EventTypeOrderConfirmationCreated,
+	EventTypeOrderConfirmationChanged,
+	EventTypeOrderConfirmationDeleted,
+	EventTypeOrderConfirmationStatusChanged,
+	EventTypePaymentChanged,
+	EventTypeQuotationCreated,
+	EventTypeQuotationChanged,
+	EventTypeQuotationDeleted,
+	EventTypeQuotationStatusChanged,
+	EventTypeRecurringTemplateCreated,
+	EventTypeRecurringTemplateChanged,
+	EventTypeRecurringTemplateDeleted,
+	EventTypeTokenRevoked,
+	EventTypeVoucherCreated,
+	EventTypeVoucherChanged,
+	EventTypeVoucherDeleted,
+	EventTypeVoucherStatusChanged,
+}
+
+// EventSubscriptionBody is to define body data
+type EventSubscriptionBody struct {
+	EventType   EventType `json:"eventType"`
+	CallbackUrl string    `json:"callbackUrl"`
+}
+
+// EventSubscriptionsReturnContent is to decode json data
+type EventSubscriptionsReturnContent struct {
+	SubscriptionId string    `json:"subscriptionId"`
+	OrganizationId string    `json:"organizationId"`
+	CreatedDate    string    `json:"createdDate"`
+	EventType      EventType `json:"eventType"`
+	CallbackUrl    string    `json:"callbackUrl"`
+}
+
+// EventSubscriptionsReturn is to decode json data
+type EventSubscriptionsReturn struct {
+	Content []EventSubscriptionsReturnContent `json:"content"`
+}
+
+// EventSubscriptionReturn is to decode json data
+type EventSubscriptionReturn struct {
+	Id          string `json:"id"`
+	ResourceUri string `json:"resourceUri"`
+	CreatedDate string `json:"createdDate"`
+	UpdatedDate string `json:"updatedDate"`
+	Version     int    `json:"version"`
+}
+
+// EventSubscriptions is to get a list of all event subscriptions
+func (c *Config) EventSubscriptions() ([]EventSubscriptionsReturnContent, error) {
+
+	// Send request
+	response, err := c.Send("/v1/event-subscriptions", nil, "GET", "application/json")
+	if err != nil {
+		return nil, err
+	}
+
+	// Close request
+	defer response.Body.Close()
+
+	// Decode data
+	var decode EventSubscriptionsReturn
+
+	err = json.NewDecoder(response.Body).Decode(&decode)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return data
+	return decode.Content, nil
+
+}
+
+// EventSubscription is to get an event subscription by id
+func (c *Config) EventSubscription(id string) (EventSubscriptionsReturnContent, error) {
+
+	// Send request
+	response, err := c.Send("/v1/event-subscriptions/"+id, nil, "GET", "application/json")
+	if err != nil {
+		return EventSubscriptionsReturnContent{}, err
+	}
+
+	// Close request
+	defer response.Body.Close()
+
+	// Decode data
+	var decode EventSubscriptionsReturnContent
+
+	err = json.NewDecoder(response.Body).Decode(&decode)
+	if err != nil {
+		return EventSubscriptionsReturnContent{}, err
+	}
+
+	// Return data
+	return decode, nil
+
+}
+
+// CreateEventSubscription is to subscribe a callback url to an event type
+func (c *Config) CreateEventSubscription(body EventSubscriptionBody) (EventSubscriptionReturn, error) {
+
+	// Convert body
+	convert, err := json.Marshal(body)
+	if err != nil {
+		return EventSubscriptionReturn{}, err
+	}
+
+	// Send request
+	response, err := c.Send("/v1/event-subscriptions", bytes.NewBuffer(convert), "POST", "application/json")
+	if err != nil {
+		return EventSubscriptionReturn{}, err
+	}
+
+	// Close request
+	defer response.Body.Close()
+
+	// Decode data
+	var decode EventSubscriptionReturn
+
+	err = json.NewDecoder(response.Body).Decode(&decode)
+	if err != nil {
+		return EventSubscriptionReturn{}, err
+	}
+
+	// Return data
+	return decode, nil
+
+}
+
+// DeleteEventSubscription is to delete an event subscription by id
+func (c *Config) DeleteEventSubscription(id string) error {
+
+	// Send request
+	response, err := c.Send("/v1/event-subscriptions/"+id, nil, "DELETE", "application/json")
+	if err != nil {
+		return err
+	}
+
+	// Close request
+	return response.Body.Close()
+
+}
@@ -0,0 +1,108 @@
+package golexoffice_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hostwithquantum/golexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventSubscriptions(t *testing.T) {
+	server := eventSubscriptionsMock()
+	defer server.Close()
+
+	lexOffice := golexoffice.NewConfig("token", nil)
+	lexOffice.SetBaseUrl(server.URL)
+
+	t.Run("create", func(t *testing.T) {
+		resp, err := lexOffice.CreateEventSubscription(golexoffice.EventSubscriptionBody{
+			EventType:   golexoffice.EventTypeInvoiceStatusChanged,
+			CallbackUrl: "https://example.org/webhooks/lexoffice",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "53d1d5f9-9d3b-4c3a-a1b0-2c1d4e5f6a7b", resp.Id)
+		assert.Equal(t, "https://api.lexoffice.io/v1/event-subscriptions/53d1d5f9-9d3b-4c3a-a1b0-2c1d4e5f6a7b", resp.ResourceUri)
+	})
+
+	t.Run("create=duplicate", func(t *testing.T) {
+		_, err := lexOffice.CreateEventSubscription(golexoffice.EventSubscriptionBody{
+			EventType:   golexoffice.EventTypeContactChanged,
+			CallbackUrl: "https://example.org/webhooks/lexoffice",
+		})
+		assert.ErrorContains(t, err, "subscription already exists")
+		// only updates are locked by the version
+		assert.False(t, errors.Is(err, golexoffice.ErrVersionConflict))
+	})
+
+	t.Run("list", func(t *testing.T) {
+		subscriptions, err := lexOffice.EventSubscriptions()
+		assert.NoError(t, err)
+		assert.Len(t, subscriptions, 1)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		subscription, err := lexOffice.EventSubscription("53d1d5f9-9d3b-4c3a-a1b0-2c1d4e5f6a7b")
+		assert.NoError(t, err)
+		assert.Equal(t, "https://example.org/webhooks/lexoffice", subscription.CallbackUrl)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		assert.NoError(t, lexOffice.DeleteEventSubscription("53d1d5f9-9d3b-4c3a-a1b0-2c1d4e5f6a7b"))
+	})
+}
+
+func TestEventTypesAreUnique(t *testing.T) {
+	seen := map[golexoffice.EventType]bool{}
+	for _, eventType := range golexoffice.EventTypes {
+		assert.False(t, seen[eventType], eventType)
+		seen[eventType] = true
+	}
+}
+
+func eventSubscriptionsMock() *httptest.Server {
+	subscription := `{
+		"subscriptionId": "53d1d5f9-9d3b-4c3a-a1b0-2c1d4e5f6a7b",
+		"organizationId": "aa93e8a8-2aa3-470b-b914-caad8a255dd8",
+		"createdDate": "2023-06-14T10:15:12.123+02:00",
+		"eventType": "invoice.status.changed",
+		"callbackUrl": "https://example.org/webhooks/lexoffice"
+	}`
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/event-subscriptions":
+			var body golexoffice.EventSubscriptionBody
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.EventType != golexoffice.EventTypeInvoiceStatusChanged {
+				w.WriteHeader(http.StatusConflict)
+				//nolint:errcheck
+				w.Write([]byte(`{"status": 409, "error": "Conflict", "message": "subscription already exists"}`))
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "53d1d5f9-9d3b-4c3a-a1b0-2c1d4e5f6a7b",
+				"resourceUri": "https://api.lexoffice.io/v1/event-subscriptions/53d1d5f9-9d3b-4c3a-a1b0-2c1d4e5f6a7b",
+				"createdDate": "2023-06-14T10:15:12.123+02:00",
+				"updatedDate": "2023-06-14T10:15:12.123+02:00",
+				"version": 0
+			}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/event-subscriptions":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"content": [` + subscription + `]}`)) //nolint:errcheck
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/event-subscriptions/53d1d5f9-9d3b-4c3a-a1b0-2c1d4e5f6a7b":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(subscription)) //nolint:errcheck
+		case r.Method == http.MethodDelete && r.URL.Path == "/v1/event-subscriptions/53d1d5f9-9d3b-4c3a-a1b0-2c1d4e5f6a7b":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
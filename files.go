@@ -10,66 +10,296 @@
 package golexoffice
 
 import (
-	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
+	"mime"
 	"mime/multipart"
+	"net/http"
+	"net/textproto"
 	"os"
+	"path/filepath"
+	"strings"
 )
 
+// Accept headers to negotiate the representation of a downloaded file
+const (
+	FileAcceptAny   = "*/*"
+	FileAcceptPDF   = "application/pdf"
+	FileAcceptImage = "image/*"
+	FileAcceptXML   = "application/xml"
+)
+
+// FileMaxSize is the maximum size of an uploaded file
+const FileMaxSize = 5 * 1024 * 1024
+
+// fileContentTypes are the content types lexoffice accepts for uploads
+var fileContentTypes = map[string]string{
+	".pdf":  "application/pdf",
+	".jpg":  "image/jpeg",
+	".jpeg": "image/jpeg",
+	".png":  "image/png",
+	".xml":  "application/xml",
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
 // FileReturn is to decode json data
 type FileReturn struct {
 	Id string `json:"id"`
 }
 
+// DocumentFile is to decode the file of a rendered sales voucher
+type DocumentFile struct {
+	DocumentFileId string `json:"documentFileId"`
+}
+
+// FileUpload is to define a file to upload
+type FileUpload struct {
+	Reader      io.Reader
+	Size        int64
+	FileName    string
+	ContentType string
+	Type        string
+}
+
+// FileUploadReturn is to decode json data and keep the uploaded metadata
+type FileUploadReturn struct {
+	FileReturn
+	FileMetadata
+}
+
+// FileMetadata is to describe a downloaded file
+type FileMetadata struct {
+	ContentType   string
+	ContentLength int64
+	FileName      string
+}
+
+// FileDownload is a streamed file, the caller has to close it
+type FileDownload struct {
+	FileMetadata
+	Body io.ReadCloser
+}
+
+// Read is to read from the file body
+func (f *FileDownload) Read(p []byte) (int, error) {
+	return f.Body.Read(p)
+}
+
+// Close is to close the file body
+func (f *FileDownload) Close() error {
+	return f.Body.Close()
+}
+
 // AddFile is to upload a file
 func (c *Config) AddFile(file *os.File, name string) (FileReturn, error) {
 
-	// Create form data
-	body := &bytes.Buffer{}
+	// Get the size, so the limit is checked before the upload
+	var size int64
+	if info, err := file.Stat(); err == nil {
+		size = info.Size()
+	}
+
+	// Upload file
+	upload, err := c.UploadFile(FileUpload{
+		Reader:   file,
+		Size:     size,
+		FileName: name,
+	})
+	if err != nil {
+		return FileReturn{}, err
+	}
+
+	// Return data
+	return upload.FileReturn, nil
+
+}
+
+// UploadFile is to upload a file from any reader
+//
+// The file is streamed to lexoffice and not buffered in memory. Size,
+// content type and file name are validated before anything is sent, the
+// content type is derived from the file name when it is empty.
+func (c *Config) UploadFile(upload FileUpload) (FileUploadReturn, error) {
+	if upload.Type == "" {
+		upload.Type = "voucher"
+	}
+	return c.uploadFile("/v1/files", upload)
+}
+
+// uploadFile is to stream a multipart upload to the path
+func (c *Config) uploadFile(path string, upload FileUpload) (FileUploadReturn, error) {
+
+	// Validate the upload
+	err := upload.validate()
+	if err != nil {
+		return FileUploadReturn{}, err
+	}
+
+	// Create a pipe, so the body is written while it is sent
+	reader, pipe := io.Pipe()
+	defer reader.Close()
 
 	// Create writer
-	writer := multipart.NewWriter(body)
+	writer := multipart.NewWriter(pipe)
+
+	// Write body data
+	go func() {
+		pipe.CloseWithError(upload.write(writer))
+	}()
+
+	// Send request
+	response, err := c.Send(path, reader, "POST", writer.FormDataContentType())
+	if err != nil {
+		return FileUploadReturn{}, err
+	}
+
+	// Close request
+	defer response.Body.Close()
+
+	// Decode data
+	decode := FileUploadReturn{
+		FileMetadata: FileMetadata{
+			ContentType:   upload.ContentType,
+			ContentLength: upload.Size,
+			FileName:      upload.FileName,
+		},
+	}
+
+	err = json.NewDecoder(response.Body).Decode(&decode)
+	if err != nil {
+		return FileUploadReturn{}, err
+	}
+
+	// Return data
+	return decode, nil
+
+}
+
+// validate is to check the upload against the limits of lexoffice
+func (u *FileUpload) validate() error {
+	if u.Reader == nil {
+		return errors.New("file upload: reader is required")
+	}
+	if u.FileName == "" {
+		return errors.New("file upload: file name is required")
+	}
+	if u.Size > FileMaxSize {
+		return fmt.Errorf("file upload: %s is too large (%d bytes, max. %d bytes)", u.FileName, u.Size, FileMaxSize)
+	}
+
+	if u.ContentType == "" {
+		u.ContentType = fileContentTypes[strings.ToLower(filepath.Ext(u.FileName))]
+	}
+	if !isAllowedContentType(u.ContentType) {
+		return fmt.Errorf("file upload: content type of %s is not supported (%q)", u.FileName, u.ContentType)
+	}
+
+	return nil
+}
+
+// write is to write the multipart body, the reader is limited to FileMaxSize
+func (u *FileUpload) write(writer *multipart.Writer) error {
 
 	// Create body data
-	filePart, err := writer.CreateFormFile("file", name)
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, quoteEscaper.Replace(u.FileName)))
+	header.Set("Content-Type", u.ContentType)
+
+	filePart, err := writer.CreatePart(header)
 	if err != nil {
-		return FileReturn{}, err
+		return err
 	}
 
 	// Copy form & file
-	_, err = io.Copy(filePart, file)
+	written, err := io.Copy(filePart, io.LimitReader(u.Reader, FileMaxSize+1))
 	if err != nil {
-		return FileReturn{}, err
+		return err
+	}
+	if written > FileMaxSize {
+		return fmt.Errorf("file upload: %s is too large (max. %d bytes)", u.FileName, FileMaxSize)
 	}
 
 	// Create text part
-	_ = writer.WriteField("type", "voucher")
+	if u.Type != "" {
+		err = writer.WriteField("type", u.Type)
+		if err != nil {
+			return err
+		}
+	}
 
 	// Close writer
-	err = writer.Close()
+	return writer.Close()
+}
+
+func isAllowedContentType(contentType string) bool {
+	for _, allowed := range fileContentTypes {
+		if contentType == allowed {
+			return true
+		}
+	}
+	return contentType == "text/xml"
+}
+
+// DownloadFile is to download a file by id
+//
+// The body is not buffered, it is streamed from the response. Use accept to
+// request a representation, e.g. FileAcceptXML for the XML of an e-invoice.
+func (c *Config) DownloadFile(id, accept string) (*FileDownload, error) {
+
+	if accept == "" {
+		accept = FileAcceptAny
+	}
+
+	// Send request
+	response, err := c.send("/v1/files/"+id, nil, "GET", "application/json", accept)
 	if err != nil {
-		return FileReturn{}, err
+		return nil, err
 	}
 
-	// Set config for new request
-	//c := NewConfig(, token, &http.Client{})
+	// Return data
+	return &FileDownload{
+		FileMetadata: fileMetadata(response.Header, response.ContentLength),
+		Body:         response.Body,
+	}, nil
+
+}
+
+// fileMetadata is to read the metadata of a file from the response headers
+func fileMetadata(header http.Header, length int64) FileMetadata {
+	metadata := FileMetadata{
+		ContentType:   header.Get("Content-Type"),
+		ContentLength: length,
+	}
+
+	if _, params, err := mime.ParseMediaType(header.Get("Content-Disposition")); err == nil {
+		metadata.FileName = params["filename"]
+	}
+
+	return metadata
+}
+
+// renderDocument is to render the document (pdf) of a sales voucher, the
+// file can be downloaded with DownloadFile afterwards
+func (c *Config) renderDocument(path string) (DocumentFile, error) {
 
 	// Send request
-	response, err := c.Send("/v1/files/", body, "POST", writer.FormDataContentType())
+	response, err := c.Send(path+"/document", nil, "GET", "application/json")
 	if err != nil {
-		return FileReturn{}, err
+		return DocumentFile{}, err
 	}
 
 	// Close request
 	defer response.Body.Close()
 
 	// Decode data
-	var decode FileReturn
+	var decode DocumentFile
 
 	err = json.NewDecoder(response.Body).Decode(&decode)
 	if err != nil {
-		return FileReturn{}, err
+		return DocumentFile{}, err
 	}
 
 	// Return data
@@ -0,0 +1,145 @@
+package golexoffice_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hostwithquantum/golexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDownloadFile(t *testing.T) {
+	server := filesMock()
+	defer server.Close()
+
+	lexOffice := golexoffice.NewConfig("token", nil)
+	lexOffice.SetBaseUrl(server.URL)
+
+	t.Run("accept=pdf", func(t *testing.T) {
+		file, err := lexOffice.DownloadFile("4ff9b8e4-8e30-4c5b-8d4a-5b4bce6f0a4e", golexoffice.FileAcceptPDF)
+		assert.NoError(t, err)
+		defer file.Close()
+
+		assert.Equal(t, "application/pdf", file.ContentType)
+		assert.Equal(t, "RE1001.pdf", file.FileName)
+		assert.Equal(t, int64(8), file.ContentLength)
+
+		content, err := io.ReadAll(file)
+		assert.NoError(t, err)
+		assert.Equal(t, "%PDF-1.4", string(content))
+	})
+
+	t.Run("accept=xml", func(t *testing.T) {
+		file, err := lexOffice.DownloadFile("4ff9b8e4-8e30-4c5b-8d4a-5b4bce6f0a4e", golexoffice.FileAcceptXML)
+		assert.NoError(t, err)
+		defer file.Close()
+
+		assert.Equal(t, "application/xml", file.ContentType)
+		assert.Equal(t, "RE1001.xml", file.FileName)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := lexOffice.DownloadFile("does-not-exist", golexoffice.FileAcceptPDF)
+		assert.Error(t, err)
+		assert.ErrorContains(t, err, "key: not_found (file): not_found")
+	})
+}
+
+func TestUploadFile(t *testing.T) {
+	server := filesMock()
+	defer server.Close()
+
+	lexOffice := golexoffice.NewConfig("token", nil)
+	lexOffice.SetBaseUrl(server.URL)
+
+	t.Run("upload=reader", func(t *testing.T) {
+		resp, err := lexOffice.UploadFile(golexoffice.FileUpload{
+			Reader:   strings.NewReader("%PDF-1.4"),
+			Size:     8,
+			FileName: "Rechnung.pdf",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "8118c402-1234-4a1b-b1f5-1ab7e8a0e4a4", resp.Id)
+		assert.Equal(t, "application/pdf", resp.ContentType)
+		assert.Equal(t, "Rechnung.pdf", resp.FileName)
+	})
+
+	t.Run("upload=too large", func(t *testing.T) {
+		_, err := lexOffice.UploadFile(golexoffice.FileUpload{
+			Reader:   strings.NewReader("%PDF-1.4"),
+			Size:     golexoffice.FileMaxSize + 1,
+			FileName: "Rechnung.pdf",
+		})
+		assert.ErrorContains(t, err, "too large")
+	})
+
+	t.Run("upload=too large without size", func(t *testing.T) {
+		_, err := lexOffice.UploadFile(golexoffice.FileUpload{
+			Reader:   bytes.NewReader(make([]byte, golexoffice.FileMaxSize+1)),
+			FileName: "Rechnung.pdf",
+		})
+		assert.ErrorContains(t, err, "too large")
+	})
+
+	t.Run("upload=unsupported type", func(t *testing.T) {
+		_, err := lexOffice.UploadFile(golexoffice.FileUpload{
+			Reader:   strings.NewReader("GIF89a"),
+			FileName: "Rechnung.gif",
+		})
+		assert.ErrorContains(t, err, "not supported")
+	})
+}
+
+func filesMock() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/v1/files" {
+			file, header, err := r.FormFile("file")
+			if err != nil || r.FormValue("type") != "voucher" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			defer file.Close()
+
+			if header.Header.Get("Content-Type") != "application/pdf" {
+				w.WriteHeader(http.StatusNotAcceptable)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"id": "8118c402-1234-4a1b-b1f5-1ab7e8a0e4a4"}`)) //nolint:errcheck
+			return
+		}
+
+		if r.Method == http.MethodGet && r.URL.Path == "/v1/files/4ff9b8e4-8e30-4c5b-8d4a-5b4bce6f0a4e" {
+			switch r.Header.Get("Accept") {
+			case golexoffice.FileAcceptPDF:
+				w.Header().Set("Content-Type", "application/pdf")
+				w.Header().Set("Content-Disposition", `attachment; filename="RE1001.pdf"`)
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`%PDF-1.4`)) //nolint:errcheck
+				return
+			case golexoffice.FileAcceptXML:
+				w.Header().Set("Content-Type", "application/xml")
+				w.Header().Set("Content-Disposition", `attachment; filename="RE1001.xml"`)
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`<?xml version="1.0"?><Invoice/>`)) //nolint:errcheck
+				return
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		//nolint:errcheck
+		w.Write([]byte(`{
+			"requestId":"75d4dad6-6ccb-40fd-8c22-797f2d421d98",
+			"IssueList":[
+				{"i18nKey":"not_found","source":"file","type":"not_found"}
+			]
+		}`))
+	}))
+}
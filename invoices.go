@@ -14,6 +14,7 @@ package golexoffice
 import (
 	"bytes"
 	"encoding/json"
+	"net/url"
 )
 
 // InvoiceBody is to define body data
@@ -39,6 +40,21 @@ type InvoiceBody struct {
 	Introduction       string                        `json:"introduction,omitempty"`
 	Remark             string                        `json:"remark,omitempty"`
 	Language           string                        `json:"language,omitempty"`
+	PrintLayoutId      string                        `json:"printLayoutId,omitempty"`
+
+	// ClosingInvoice marks the final invoice of a project with down payments,
+	// the down payment invoices are deducted from it
+	ClosingInvoice        bool                               `json:"closingInvoice,omitempty"`
+	ClaimedGrossAmount    interface{}                        `json:"claimedGrossAmount,omitempty"`
+	DownPaymentDeductions []InvoiceBodyDownPaymentDeductions `json:"downPaymentDeductions,omitempty"`
+
+	// PrecedingSalesVoucherId is to pursue the invoice from a preceding sales
+	// voucher (e.g. an accepted quotation), it is sent as query parameter only
+	PrecedingSalesVoucherId string `json:"-"`
+
+	// XRechnung is required to create the invoice as XRechnung, see
+	// ValidateXRechnung
+	XRechnung *InvoiceBodyXRechnung `json:"xRechnung,omitempty"`
 }
 
 type InvoiceBodyAddress struct {
@@ -98,8 +114,8 @@ type InvoiceBodyPaymentConditions struct {
 }
 
 type InvoiceBodyPaymentDiscountConditions struct {
-	DiscountPercentage int `json:"discountPercentage"`
-	DiscountRange      int `json:"discountRange"`
+	DiscountPercentage float64 `json:"discountPercentage"`
+	DiscountRange      int     `json:"discountRange"`
 }
 
 type InvoiceBodyShippingConditions struct {
@@ -108,6 +124,26 @@ type InvoiceBodyShippingConditions struct {
 	ShippingType    string      `json:"shippingType"`
 }
 
+type InvoiceBodyDownPaymentDeductions struct {
+	Id                  string      `json:"id"`
+	VoucherType         VoucherType `json:"voucherType"`
+	Title               string      `json:"title,omitempty"`
+	VoucherNumber       string      `json:"voucherNumber,omitempty"`
+	VoucherDate         string      `json:"voucherDate,omitempty"`
+	ReceivedNetAmount   float64     `json:"receivedNetAmount"`
+	ReceivedTaxAmount   float64     `json:"receivedTaxAmount"`
+	ReceivedGrossAmount float64     `json:"receivedGrossAmount"`
+	TaxRatePercentage   float64     `json:"taxRatePercentage"`
+}
+
+// RelatedVoucher is a sales voucher linked to another one (e.g. the invoice
+// pursued from a delivery note)
+type RelatedVoucher struct {
+	Id            string      `json:"id"`
+	VoucherNumber string      `json:"voucherNumber"`
+	VoucherType   VoucherType `json:"voucherType"`
+}
+
 // InvoiceReturn is to decode json data
 type InvoiceReturn struct {
 	Id          string `json:"id"`
@@ -168,11 +204,7 @@ func (c *Config) AddInvoice(body InvoiceBody) (InvoiceReturn, error) {
 	//c := NewConfig(, token, &http.Client{})
 
 	// Send request
-	url := "/v1/invoices"
-	if isOpen {
-		url += "?finalize=true"
-	}
-	response, err := c.Send(url, bytes.NewBuffer(convert), "POST", "application/json")
+	response, err := c.Send("/v1/invoices"+salesVoucherQuery(isOpen, body.PrecedingSalesVoucherId), bytes.NewBuffer(convert), "POST", "application/json")
 	if err != nil {
 		return InvoiceReturn{}, err
 	}
@@ -192,3 +224,40 @@ func (c *Config) AddInvoice(body InvoiceBody) (InvoiceReturn, error) {
 	return decode, nil
 
 }
+
+// InvoiceDocument is to render the pdf of a invoice
+func (c *Config) InvoiceDocument(id string) (DocumentFile, error) {
+	return c.renderDocument("/v1/invoices/" + id)
+}
+
+// salesVoucherQuery is to build the query to create a sales voucher, either
+// finalized (open) or pursued from a preceding sales voucher
+func salesVoucherQuery(finalize bool, precedingSalesVoucherId string) string {
+	query := url.Values{}
+	if precedingSalesVoucherId != "" {
+		query.Set("precedingSalesVoucherId", precedingSalesVoucherId)
+	}
+	if finalize {
+		query.Set("finalize", "true")
+	}
+	if len(query) == 0 {
+		return ""
+	}
+	return "?" + query.Encode()
+}
+
+// pursueLineItems is to copy the line items of a preceding sales voucher,
+// they are created again on the pursued voucher
+//
+// The id of "material" and "service" items references an article, so it is
+// kept for these.
+func pursueLineItems(items []InvoiceBodyLineItems) []InvoiceBodyLineItems {
+	lineItems := make([]InvoiceBodyLineItems, 0, len(items))
+	for _, item := range items {
+		if item.Type != "material" && item.Type != "service" {
+			item.Id = ""
+		}
+		lineItems = append(lineItems, item)
+	}
+	return lineItems
+}
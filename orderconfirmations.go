@@ -0,0 +1,134 @@
+package golexoffice
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// OrderConfirmationBody is to define body data
+type OrderConfirmationBody struct {
+	Id                 string                        `json:"id,omitempty"`
+	OrganizationId     string                        `json:"organizationId,omitempty"`
+	CreatedDate        string                        `json:"createdDate,omitempty"`
+	UpdatedDate        string                        `json:"updatedDate,omitempty"`
+	Version            int                           `json:"version,omitempty"`
+	Archived           bool                          `json:"archived,omitempty"`
+	VoucherStatus      string                        `json:"voucherStatus,omitempty"`
+	VoucherNumber      string                        `json:"voucherNumber,omitempty"`
+	VoucherDate        string                        `json:"voucherDate"`
+	Address            InvoiceBodyAddress            `json:"address"`
+	LineItems          []InvoiceBodyLineItems        `json:"lineItems"`
+	TotalPrice         InvoiceBodyTotalPrice         `json:"totalPrice"`
+	TaxAmounts         []InvoiceBodyTaxAmounts       `json:"taxAmounts,omitempty"`
+	TaxConditions      InvoiceBodyTaxConditions      `json:"taxConditions"`
+	PaymentConditions  *InvoiceBodyPaymentConditions `json:"paymentConditions,omitempty"`
+	ShippingConditions InvoiceBodyShippingConditions `json:"shippingConditions"`
+	Title              string                        `json:"title,omitempty"`
+	Introduction       string                        `json:"introduction,omitempty"`
+	Remark             string                        `json:"remark,omitempty"`
+	DeliveryTerms      string                        `json:"deliveryTerms,omitempty"`
+	Language           string                        `json:"language,omitempty"`
+	PrintLayoutId      string                        `json:"printLayoutId,omitempty"`
+	Files              *DocumentFile                 `json:"files,omitempty"`
+
+	// PrecedingSalesVoucherId is to pursue the order confirmation from a
+	// quotation, it is sent as query parameter only
+	PrecedingSalesVoucherId string `json:"-"`
+}
+
+// OrderConfirmationReturn is to decode json data
+type OrderConfirmationReturn struct {
+	Id          string `json:"id"`
+	ResourceUri string `json:"resourceUri"`
+	CreatedDate string `json:"createdDate"`
+	UpdatedDate string `json:"updatedDate"`
+	Version     int    `json:"version"`
+}
+
+// OrderConfirmation is to get an order confirmation by id
+func (c *Config) OrderConfirmation(id string) (OrderConfirmationBody, error) {
+
+	// Send request
+	response, err := c.Send("/v1/order-confirmations/"+id, nil, "GET", "application/json")
+	if err != nil {
+		return OrderConfirmationBody{}, err
+	}
+
+	// Close request
+	defer response.Body.Close()
+
+	// Decode data
+	var decode OrderConfirmationBody
+
+	err = json.NewDecoder(response.Body).Decode(&decode)
+	if err != nil {
+		return OrderConfirmationBody{}, err
+	}
+
+	// Return data
+	return decode, nil
+
+}
+
+// AddOrderConfirmation is to create an order confirmation
+func (c *Config) AddOrderConfirmation(body OrderConfirmationBody) (OrderConfirmationReturn, error) {
+
+	query := salesVoucherQuery(false, body.PrecedingSalesVoucherId)
+	body.VoucherStatus = "" // unset for the request
+
+	// Convert body
+	convert, err := json.Marshal(body)
+	if err != nil {
+		return OrderConfirmationReturn{}, err
+	}
+
+	// Send request
+	response, err := c.Send("/v1/order-confirmations"+query, bytes.NewBuffer(convert), "POST", "application/json")
+	if err != nil {
+		return OrderConfirmationReturn{}, err
+	}
+
+	// Close request
+	defer response.Body.Close()
+
+	// Decode data
+	var decode OrderConfirmationReturn
+
+	err = json.NewDecoder(response.Body).Decode(&decode)
+	if err != nil {
+		return OrderConfirmationReturn{}, err
+	}
+
+	// Return data
+	return decode, nil
+
+}
+
+// OrderConfirmationDocument is to render the pdf of an order confirmation
+func (c *Config) OrderConfirmationDocument(id string) (DocumentFile, error) {
+	return c.renderDocument("/v1/order-confirmations/" + id)
+}
+
+// OrderConfirmationDeeplink is to link to an order confirmation in the lexoffice UI
+func (c *Config) OrderConfirmationDeeplink(id string, action DeeplinkAction) string {
+	return c.deeplink("order-confirmations", action, id)
+}
+
+// InvoiceBody is to pursue the order confirmation into an invoice, the
+// result can be adjusted before it is sent with AddInvoice
+func (o OrderConfirmationBody) InvoiceBody(voucherDate string) InvoiceBody {
+	return InvoiceBody{
+		VoucherDate:             voucherDate,
+		Address:                 o.Address,
+		LineItems:               pursueLineItems(o.LineItems),
+		TotalPrice:              InvoiceBodyTotalPrice{Currency: o.TotalPrice.Currency},
+		TaxConditions:           o.TaxConditions,
+		PaymentConditions:       o.PaymentConditions,
+		ShippingConditions:      o.ShippingConditions,
+		Introduction:            o.Introduction,
+		Remark:                  o.Remark,
+		Language:                o.Language,
+		PrintLayoutId:           o.PrintLayoutId,
+		PrecedingSalesVoucherId: o.Id,
+	}
+}
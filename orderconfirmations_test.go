@@ -0,0 +1,101 @@
+package golexoffice_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hostwithquantum/golexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderConfirmations(t *testing.T) {
+	server := orderConfirmationsMock()
+	defer server.Close()
+
+	lexOffice := golexoffice.NewConfig("token", nil)
+	lexOffice.SetBaseUrl(server.URL)
+
+	t.Run("create", func(t *testing.T) {
+		resp, err := lexOffice.AddOrderConfirmation(golexoffice.OrderConfirmationBody{
+			VoucherDate:             "2023-06-14T00:00:00.000+02:00",
+			PrecedingSalesVoucherId: "424f784e-1f4e-439e-8f71-19673e6d6583",
+			DeliveryTerms:           "Lieferung frei Haus",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "fd6d2a1a-0e93-4b5f-9f6e-14d4b2f6d0c1", resp.Id)
+	})
+
+	t.Run("get and pursue", func(t *testing.T) {
+		orderConfirmation, err := lexOffice.OrderConfirmation("fd6d2a1a-0e93-4b5f-9f6e-14d4b2f6d0c1")
+		assert.NoError(t, err)
+		assert.Equal(t, "AB0001", orderConfirmation.VoucherNumber)
+		assert.Equal(t, "Lieferung frei Haus", orderConfirmation.DeliveryTerms)
+
+		invoice := orderConfirmation.InvoiceBody("2023-06-20T00:00:00.000+02:00")
+		assert.Equal(t, "fd6d2a1a-0e93-4b5f-9f6e-14d4b2f6d0c1", invoice.PrecedingSalesVoucherId)
+		assert.Equal(t, "e9066f04-8cc7-4616-93f8-ac9ecc8479c8", invoice.Address.ContactId)
+		assert.Equal(t, "EUR", invoice.TotalPrice.Currency)
+		assert.Len(t, invoice.LineItems, 1)
+		assert.Empty(t, invoice.LineItems[0].Id)
+		assert.Equal(t, "Appliance", invoice.LineItems[0].Name)
+	})
+
+	t.Run("document", func(t *testing.T) {
+		document, err := lexOffice.OrderConfirmationDocument("fd6d2a1a-0e93-4b5f-9f6e-14d4b2f6d0c1")
+		assert.NoError(t, err)
+		assert.Equal(t, "7bd2d25e-1f9a-4b8e-a0c2-5b2f2d5c2e13", document.DocumentFileId)
+	})
+
+	t.Run("deeplink", func(t *testing.T) {
+		assert.Equal(t,
+			"https://app.lexoffice.de/permalink/order-confirmations/view/fd6d2a1a-0e93-4b5f-9f6e-14d4b2f6d0c1",
+			lexOffice.OrderConfirmationDeeplink("fd6d2a1a-0e93-4b5f-9f6e-14d4b2f6d0c1", golexoffice.DeeplinkView))
+	})
+}
+
+func orderConfirmationsMock() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/order-confirmations" &&
+			r.URL.Query().Get("precedingSalesVoucherId") == "424f784e-1f4e-439e-8f71-19673e6d6583":
+			w.WriteHeader(http.StatusCreated)
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "fd6d2a1a-0e93-4b5f-9f6e-14d4b2f6d0c1",
+				"resourceUri": "https://api.lexoffice.io/v1/order-confirmations/fd6d2a1a-0e93-4b5f-9f6e-14d4b2f6d0c1",
+				"version": 1
+			}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/order-confirmations/fd6d2a1a-0e93-4b5f-9f6e-14d4b2f6d0c1":
+			w.WriteHeader(http.StatusOK)
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "fd6d2a1a-0e93-4b5f-9f6e-14d4b2f6d0c1",
+				"version": 1,
+				"voucherStatus": "draft",
+				"voucherNumber": "AB0001",
+				"voucherDate": "2023-06-14T00:00:00.000+02:00",
+				"address": {"contactId": "e9066f04-8cc7-4616-93f8-ac9ecc8479c8"},
+				"lineItems": [{
+					"id": "97b98491-e953-4dc9-97a9-ae437a8052b4",
+					"type": "custom",
+					"name": "Appliance",
+					"quantity": 1,
+					"unitName": "Stück",
+					"unitPrice": {"currency": "EUR", "netAmount": 1000, "taxRatePercentage": 19}
+				}],
+				"totalPrice": {"currency": "EUR", "totalNetAmount": 1000, "totalGrossAmount": 1190, "totalTaxAmount": 190},
+				"taxConditions": {"taxType": "net"},
+				"shippingConditions": {"shippingType": "delivery", "shippingDate": "2023-06-20T00:00:00.000+02:00"},
+				"deliveryTerms": "Lieferung frei Haus"
+			}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/order-confirmations/fd6d2a1a-0e93-4b5f-9f6e-14d4b2f6d0c1/document":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"documentFileId": "7bd2d25e-1f9a-4b8e-a0c2-5b2f2d5c2e13"}`)) //nolint:errcheck
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
@@ -0,0 +1,113 @@
+package golexoffice
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+// Page is to decode a page of a paginated endpoint
+type Page[T any] struct {
+	Content          []T                  `json:"content"`
+	First            bool                 `json:"first"`
+	Last             bool                 `json:"last"`
+	TotalPages       int                  `json:"totalPages"`
+	TotalElements    int                  `json:"totalElements"`
+	NumberOfElements int                  `json:"numberOfElements"`
+	Size             int                  `json:"size"`
+	Number           int                  `json:"number"`
+	Sort             []ContactsReturnSort `json:"sort"`
+}
+
+// Pager is to iterate over all elements of a paginated endpoint, the next
+// page is only requested when the current one is consumed
+//
+//	pager := client.Voucherlist(filter)
+//	for pager.Next() {
+//		voucher := pager.Value()
+//	}
+//	if err := pager.Err(); err != nil {
+//		...
+//	}
+type Pager[T any] struct {
+	config *Config
+	path   string
+	query  url.Values
+
+	page    int
+	content []T
+	index   int
+	value   T
+	done    bool
+	err     error
+}
+
+func newPager[T any](c *Config, path string, query url.Values) *Pager[T] {
+	if query == nil {
+		query = url.Values{}
+	}
+	return &Pager[T]{config: c, path: path, query: query}
+}
+
+// Next is to advance to the next element, it returns false when all elements
+// are consumed or an error occurred
+func (p *Pager[T]) Next() bool {
+	for p.index >= len(p.content) {
+		if p.done || p.err != nil {
+			return false
+		}
+		p.err = p.fetch()
+	}
+
+	p.value = p.content[p.index]
+	p.index++
+
+	return true
+}
+
+// Value is to get the current element
+func (p *Pager[T]) Value() T {
+	return p.value
+}
+
+// Err is to get the error which stopped the iteration
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// All is to collect all (remaining) elements
+func (p *Pager[T]) All() ([]T, error) {
+	var all []T
+	for p.Next() {
+		all = append(all, p.Value())
+	}
+	return all, p.Err()
+}
+
+func (p *Pager[T]) fetch() error {
+	p.query.Set("page", strconv.Itoa(p.page))
+
+	// Send request
+	response, err := p.config.Send(p.path+"?"+p.query.Encode(), nil, "GET", "application/json")
+	if err != nil {
+		return err
+	}
+
+	// Close request
+	defer response.Body.Close()
+
+	// Decode data
+	var decode Page[T]
+
+	err = json.NewDecoder(response.Body).Decode(&decode)
+	if err != nil {
+		return err
+	}
+
+	p.content = decode.Content
+	p.index = 0
+	p.page++
+	p.done = decode.Last || len(decode.Content) == 0 || p.page >= decode.TotalPages
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package golexoffice
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PaymentConditionReturn is to decode json data
+type PaymentConditionReturn struct {
+	Id                        string                                    `json:"id"`
+	OrganizationDefault       bool                                      `json:"organizationDefault"`
+	PaymentTermLabelTemplate  string                                    `json:"paymentTermLabelTemplate"`
+	PaymentTermDuration       int                                       `json:"paymentTermDuration"`
+	PaymentDiscountConditions *PaymentConditionReturnDiscountConditions `json:"paymentDiscountConditions,omitempty"`
+}
+
+type PaymentConditionReturnDiscountConditions struct {
+	DiscountPercentage float64 `json:"discountPercentage"`
+	DiscountRange      int     `json:"discountRange"`
+}
+
+// PaymentConditions is to get a list of the payment conditions of the organization
+func (c *Config) PaymentConditions() ([]PaymentConditionReturn, error) {
+
+	// Send request
+	response, err := c.Send("/v1/payment-conditions", nil, "GET", "application/json")
+	if err != nil {
+		return nil, err
+	}
+
+	// Close request
+	defer response.Body.Close()
+
+	// Decode data
+	var decode []PaymentConditionReturn
+
+	err = json.NewDecoder(response.Body).Decode(&decode)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return data
+	return decode, nil
+
+}
+
+// DefaultPaymentCondition is to get the default payment condition of the organization
+func (c *Config) DefaultPaymentCondition() (PaymentConditionReturn, error) {
+	conditions, err := c.PaymentConditions()
+	if err != nil {
+		return PaymentConditionReturn{}, err
+	}
+
+	for _, condition := range conditions {
+		if condition.OrganizationDefault {
+			return condition, nil
+		}
+	}
+
+	return PaymentConditionReturn{}, errors.New("organization has no default payment condition")
+}
+
+// InvoicePaymentConditions is to use the payment condition on an invoice, the
+// placeholders of the label template are replaced for the voucher date
+func (p PaymentConditionReturn) InvoicePaymentConditions(voucherDate time.Time) *InvoiceBodyPaymentConditions {
+	conditions := &InvoiceBodyPaymentConditions{
+		PaymentTermDuration: p.PaymentTermDuration,
+	}
+
+	replacements := []string{
+		"{paymentRange}", strconv.Itoa(p.PaymentTermDuration),
+		"{paymentDate}", voucherDate.AddDate(0, 0, p.PaymentTermDuration).Format("02.01.2006"),
+	}
+
+	if p.PaymentDiscountConditions != nil {
+		conditions.PaymentDiscountConditions = InvoiceBodyPaymentDiscountConditions{
+			DiscountPercentage: p.PaymentDiscountConditions.DiscountPercentage,
+			DiscountRange:      p.PaymentDiscountConditions.DiscountRange,
+		}
+
+		replacements = append(replacements,
+			"{discount}", strconv.FormatFloat(p.PaymentDiscountConditions.DiscountPercentage, 'f', -1, 64)+"%",
+			"{discountRange}", strconv.Itoa(p.PaymentDiscountConditions.DiscountRange),
+			"{discountDate}", voucherDate.AddDate(0, 0, p.PaymentDiscountConditions.DiscountRange).Format("02.01.2006"),
+		)
+	}
+
+	conditions.PaymentTermLabel = strings.NewReplacer(replacements...).Replace(p.PaymentTermLabelTemplate)
+
+	return conditions
+}
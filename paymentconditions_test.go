@@ -0,0 +1,60 @@
+package golexoffice_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hostwithquantum/golexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaymentConditions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/payment-conditions" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		//nolint:errcheck
+		w.Write([]byte(`[
+			{
+				"id": "3d7b5bde-5c4a-4f3e-8c6d-2a7e8f9b0c1d",
+				"organizationDefault": false,
+				"paymentTermLabelTemplate": "Zahlbar sofort, rein netto",
+				"paymentTermDuration": 0
+			},
+			{
+				"id": "f2b1c3d4-6e5f-4a7b-9c8d-0e1f2a3b4c5d",
+				"organizationDefault": true,
+				"paymentTermLabelTemplate": "{discountRange} Tage -{discount}, {paymentRange} Tage netto (bis {paymentDate})",
+				"paymentTermDuration": 30,
+				"paymentDiscountConditions": {"discountPercentage": 2.5, "discountRange": 10}
+			}
+		]`))
+	}))
+	defer server.Close()
+
+	lexOffice := golexoffice.NewConfig("token", nil)
+	lexOffice.SetBaseUrl(server.URL)
+
+	conditions, err := lexOffice.PaymentConditions()
+	assert.NoError(t, err)
+	assert.Len(t, conditions, 2)
+
+	condition, err := lexOffice.DefaultPaymentCondition()
+	assert.NoError(t, err)
+	assert.Equal(t, "f2b1c3d4-6e5f-4a7b-9c8d-0e1f2a3b4c5d", condition.Id)
+
+	invoiceConditions := condition.InvoicePaymentConditions(time.Date(2023, 6, 14, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, "10 Tage -2.5%, 30 Tage netto (bis 14.07.2023)", invoiceConditions.PaymentTermLabel)
+	assert.Equal(t, 30, invoiceConditions.PaymentTermDuration)
+	assert.Equal(t, 2.5, invoiceConditions.PaymentDiscountConditions.DiscountPercentage)
+	assert.Equal(t, 10, invoiceConditions.PaymentDiscountConditions.DiscountRange)
+
+	immediately := conditions[0].InvoicePaymentConditions(time.Now())
+	assert.Equal(t, "Zahlbar sofort, rein netto", immediately.PaymentTermLabel)
+	assert.Equal(t, 0, immediately.PaymentDiscountConditions.DiscountRange)
+}
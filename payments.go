@@ -0,0 +1,63 @@
+package golexoffice
+
+import (
+	"encoding/json"
+)
+
+// PaymentStatus is the payment status of a voucher
+type PaymentStatus string
+
+const (
+	PaymentStatusBalanced    PaymentStatus = "balanced"
+	PaymentStatusOpenRevenue PaymentStatus = "openRevenue"
+	PaymentStatusOpenExpense PaymentStatus = "openExpense"
+)
+
+// PaymentReturn is to decode json data
+type PaymentReturn struct {
+	OpenAmount    float64              `json:"openAmount"`
+	Currency      string               `json:"currency"`
+	PaymentStatus PaymentStatus        `json:"paymentStatus"`
+	VoucherType   VoucherType          `json:"voucherType"`
+	VoucherStatus VoucherStatus        `json:"voucherStatus"`
+	PaidDate      string               `json:"paidDate,omitempty"`
+	PaymentItems  []PaymentReturnItems `json:"paymentItems"`
+}
+
+type PaymentReturnItems struct {
+	PaymentItemType string  `json:"paymentItemType"`
+	PostingDate     string  `json:"postingDate"`
+	Amount          float64 `json:"amount"`
+	Currency        string  `json:"currency"`
+	ExchangeRate    float64 `json:"exchangeRate,omitempty"`
+}
+
+// IsPaid is to check if the voucher is paid completely
+func (p PaymentReturn) IsPaid() bool {
+	return p.PaymentStatus == PaymentStatusBalanced
+}
+
+// Payment is to get the payment status of a voucher (e.g. an invoice) by id
+func (c *Config) Payment(voucherId string) (PaymentReturn, error) {
+
+	// Send request
+	response, err := c.Send("/v1/payments/"+voucherId, nil, "GET", "application/json")
+	if err != nil {
+		return PaymentReturn{}, err
+	}
+
+	// Close request
+	defer response.Body.Close()
+
+	// Decode data
+	var decode PaymentReturn
+
+	err = json.NewDecoder(response.Body).Decode(&decode)
+	if err != nil {
+		return PaymentReturn{}, err
+	}
+
+	// Return data
+	return decode, nil
+
+}
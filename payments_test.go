@@ -0,0 +1,74 @@
+package golexoffice_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hostwithquantum/golexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPayment(t *testing.T) {
+	server := paymentsMock()
+	defer server.Close()
+
+	lexOffice := golexoffice.NewConfig("token", nil)
+	lexOffice.SetBaseUrl(server.URL)
+
+	t.Run("status=paid", func(t *testing.T) {
+		payment, err := lexOffice.Payment("0cf8142b-6f54-4c96-9766-6f44a9a4814b")
+		assert.NoError(t, err)
+		assert.True(t, payment.IsPaid())
+		assert.Equal(t, 0.0, payment.OpenAmount)
+		assert.Equal(t, golexoffice.VoucherStatusPaid, payment.VoucherStatus)
+		assert.Equal(t, "2023-06-20T12:01:12.000+02:00", payment.PaidDate)
+		assert.Len(t, payment.PaymentItems, 1)
+		assert.Equal(t, 119.0, payment.PaymentItems[0].Amount)
+	})
+
+	t.Run("status=open", func(t *testing.T) {
+		payment, err := lexOffice.Payment("6f1e4d3c-2b1a-4f9e-8d7c-6b5a4f3e2d1c")
+		assert.NoError(t, err)
+		assert.False(t, payment.IsPaid())
+		assert.Equal(t, golexoffice.PaymentStatusOpenRevenue, payment.PaymentStatus)
+		assert.Equal(t, 119.0, payment.OpenAmount)
+		assert.Empty(t, payment.PaymentItems)
+	})
+}
+
+func paymentsMock() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v1/payments/0cf8142b-6f54-4c96-9766-6f44a9a4814b":
+			w.WriteHeader(http.StatusOK)
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"openAmount": 0.00,
+				"currency": "EUR",
+				"paymentStatus": "balanced",
+				"voucherType": "invoice",
+				"voucherStatus": "paid",
+				"paidDate": "2023-06-20T12:01:12.000+02:00",
+				"paymentItems": [
+					{"paymentItemType": "manualPayment", "postingDate": "2023-06-20T12:01:12.000+02:00", "amount": 119.00, "currency": "EUR"}
+				]
+			}`))
+		case "/v1/payments/6f1e4d3c-2b1a-4f9e-8d7c-6b5a4f3e2d1c":
+			w.WriteHeader(http.StatusOK)
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"openAmount": 119.00,
+				"currency": "EUR",
+				"paymentStatus": "openRevenue",
+				"voucherType": "invoice",
+				"voucherStatus": "open",
+				"paymentItems": []
+			}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
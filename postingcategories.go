@@ -0,0 +1,70 @@
+package golexoffice
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// PostingCategoryType is the classification of a posting category
+type PostingCategoryType string
+
+const (
+	PostingCategoryTypeIncome PostingCategoryType = "income"
+	PostingCategoryTypeOutgo  PostingCategoryType = "outgo"
+)
+
+// PostingCategoryReturn is to decode json data
+type PostingCategoryReturn struct {
+	Id              string              `json:"id"`
+	Name            string              `json:"name"`
+	Type            PostingCategoryType `json:"type"`
+	ContactRequired bool                `json:"contactRequired"`
+	SplitAllowed    bool                `json:"splitAllowed"`
+	GroupName       string              `json:"groupName"`
+}
+
+// PostingCategories is to get a list of all posting categories, the list is
+// cached (see SetCacheTTL)
+func (c *Config) PostingCategories() ([]PostingCategoryReturn, error) {
+	return c.postingCategories.get(c.cacheTTL, func() ([]PostingCategoryReturn, error) {
+
+		// Send request
+		response, err := c.Send("/v1/posting-categories", nil, "GET", "application/json")
+		if err != nil {
+			return nil, err
+		}
+
+		// Close request
+		defer response.Body.Close()
+
+		// Decode data
+		var decode []PostingCategoryReturn
+
+		err = json.NewDecoder(response.Body).Decode(&decode)
+		if err != nil {
+			return nil, err
+		}
+
+		// Return data
+		return decode, nil
+
+	})
+}
+
+// PostingCategory is to get a posting category of the type by its name, the
+// id is the CategoryId of VoucherBodyItems
+func (c *Config) PostingCategory(categoryType PostingCategoryType, name string) (PostingCategoryReturn, error) {
+	categories, err := c.PostingCategories()
+	if err != nil {
+		return PostingCategoryReturn{}, err
+	}
+
+	for _, category := range categories {
+		if category.Type == categoryType && strings.EqualFold(category.Name, name) {
+			return category, nil
+		}
+	}
+
+	return PostingCategoryReturn{}, fmt.Errorf("unknown %s posting category: %s", categoryType, name)
+}
@@ -0,0 +1,60 @@
+package golexoffice_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hostwithquantum/golexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostingCategories(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/posting-categories" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		//nolint:errcheck
+		w.Write([]byte(`[
+			{
+				"id": "8f8664a8-fd86-11e1-a21f-0800200c9a66",
+				"name": "Einnahmen",
+				"type": "income",
+				"contactRequired": false,
+				"splitAllowed": true,
+				"groupName": "Einnahmen"
+			},
+			{
+				"id": "16d04a28-8aaa-4c3a-ab61-c1e1f7d8ba0a",
+				"name": "Software",
+				"type": "outgo",
+				"contactRequired": false,
+				"splitAllowed": true,
+				"groupName": "Bürokosten"
+			}
+		]`))
+	}))
+	defer server.Close()
+
+	lexOffice := golexoffice.NewConfig("token", nil)
+	lexOffice.SetBaseUrl(server.URL)
+
+	categories, err := lexOffice.PostingCategories()
+	assert.NoError(t, err)
+	assert.Len(t, categories, 2)
+	assert.Equal(t, golexoffice.PostingCategoryTypeIncome, categories[0].Type)
+
+	category, err := lexOffice.PostingCategory(golexoffice.PostingCategoryTypeOutgo, "software")
+	assert.NoError(t, err)
+	assert.Equal(t, "16d04a28-8aaa-4c3a-ab61-c1e1f7d8ba0a", category.Id)
+	assert.Equal(t, "Bürokosten", category.GroupName)
+	assert.Equal(t, 1, requests)
+
+	_, err = lexOffice.PostingCategory(golexoffice.PostingCategoryTypeIncome, "Software")
+	assert.Error(t, err)
+}
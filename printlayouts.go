@@ -0,0 +1,73 @@
+package golexoffice
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// PrintLayoutReturn is to decode json data
+type PrintLayoutReturn struct {
+	Id      string `json:"id"`
+	Name    string `json:"name"`
+	Default bool   `json:"default"`
+}
+
+// PrintLayouts is to get a list of the print layouts of the organization
+func (c *Config) PrintLayouts() ([]PrintLayoutReturn, error) {
+
+	// Send request
+	response, err := c.Send("/v1/print-layouts", nil, "GET", "application/json")
+	if err != nil {
+		return nil, err
+	}
+
+	// Close request
+	defer response.Body.Close()
+
+	// Decode data
+	var decode []PrintLayoutReturn
+
+	err = json.NewDecoder(response.Body).Decode(&decode)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return data
+	return decode, nil
+
+}
+
+// PrintLayout is to get a print layout by its name, the id is the
+// PrintLayoutId of the sales vouchers
+func (c *Config) PrintLayout(name string) (PrintLayoutReturn, error) {
+	layouts, err := c.PrintLayouts()
+	if err != nil {
+		return PrintLayoutReturn{}, err
+	}
+
+	for _, layout := range layouts {
+		if strings.EqualFold(layout.Name, name) {
+			return layout, nil
+		}
+	}
+
+	return PrintLayoutReturn{}, fmt.Errorf("unknown print layout: %s", name)
+}
+
+// DefaultPrintLayout is to get the default print layout of the organization
+func (c *Config) DefaultPrintLayout() (PrintLayoutReturn, error) {
+	layouts, err := c.PrintLayouts()
+	if err != nil {
+		return PrintLayoutReturn{}, err
+	}
+
+	for _, layout := range layouts {
+		if layout.Default {
+			return layout, nil
+		}
+	}
+
+	return PrintLayoutReturn{}, errors.New("organization has no default print layout")
+}
@@ -0,0 +1,56 @@
+package golexoffice_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hostwithquantum/golexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintLayouts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/print-layouts" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		//nolint:errcheck
+		w.Write([]byte(`[
+			{"id": "28c212c4-b6dd-11ee-b80a-dbc65f4ceccf", "name": "Standard", "default": true},
+			{"id": "e4c6b5a2-3f1d-4c8e-9a7b-6d5e4f3a2b1c", "name": "Brand B", "default": false}
+		]`))
+	}))
+	defer server.Close()
+
+	lexOffice := golexoffice.NewConfig("token", nil)
+	lexOffice.SetBaseUrl(server.URL)
+
+	layouts, err := lexOffice.PrintLayouts()
+	assert.NoError(t, err)
+	assert.Len(t, layouts, 2)
+
+	layout, err := lexOffice.PrintLayout("brand b")
+	assert.NoError(t, err)
+	assert.Equal(t, "e4c6b5a2-3f1d-4c8e-9a7b-6d5e4f3a2b1c", layout.Id)
+
+	_, err = lexOffice.PrintLayout("Brand C")
+	assert.Error(t, err)
+
+	standard, err := lexOffice.DefaultPrintLayout()
+	assert.NoError(t, err)
+	assert.Equal(t, "Standard", standard.Name)
+
+	t.Run("invoice", func(t *testing.T) {
+		data, err := json.Marshal(golexoffice.InvoiceBody{PrintLayoutId: layout.Id})
+		assert.NoError(t, err)
+		assert.Contains(t, string(data), `"printLayoutId":"e4c6b5a2-3f1d-4c8e-9a7b-6d5e4f3a2b1c"`)
+
+		data, err = json.Marshal(golexoffice.InvoiceBody{})
+		assert.NoError(t, err)
+		assert.NotContains(t, string(data), "printLayoutId")
+	})
+}
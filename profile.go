@@ -0,0 +1,72 @@
+package golexoffice
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ProfileReturn is to decode json data
+type ProfileReturn struct {
+	OrganizationId     string               `json:"organizationId"`
+	CompanyName        string               `json:"companyName"`
+	Created            ProfileReturnCreated `json:"created"`
+	ConnectionId       string               `json:"connectionId"`
+	Features           []string             `json:"features"`
+	BusinessFeatures   []string             `json:"businessFeatures"`
+	SubscriptionStatus string               `json:"subscriptionStatus"`
+	TaxType            string               `json:"taxType"`
+	SmallBusiness      bool                 `json:"smallBusiness"`
+}
+
+type ProfileReturnCreated struct {
+	UserId    string `json:"userId"`
+	UserName  string `json:"userName"`
+	UserEmail string `json:"userEmail"`
+	Date      string `json:"date"`
+}
+
+// Profile is to get the profile of the organization the token belongs to
+func (c *Config) Profile() (ProfileReturn, error) {
+
+	// Send request
+	response, err := c.Send("/v1/profile", nil, "GET", "application/json")
+	if err != nil {
+		return ProfileReturn{}, err
+	}
+
+	// Close request
+	defer response.Body.Close()
+
+	// Decode data
+	var decode ProfileReturn
+
+	err = json.NewDecoder(response.Body).Decode(&decode)
+	if err != nil {
+		return ProfileReturn{}, err
+	}
+
+	// Return data
+	return decode, nil
+
+}
+
+// Ping is to check if lexoffice is reachable and the token is valid
+func (c *Config) Ping() error {
+	_, err := c.Profile()
+	return err
+}
+
+// VerifyOrganization is to check if the token belongs to the organization,
+// e.g. at startup when several organizations are used
+func (c *Config) VerifyOrganization(organizationId string) error {
+	profile, err := c.Profile()
+	if err != nil {
+		return err
+	}
+
+	if profile.OrganizationId != organizationId {
+		return fmt.Errorf("token belongs to organization %s (%s), not %s", profile.OrganizationId, profile.CompanyName, organizationId)
+	}
+
+	return nil
+}
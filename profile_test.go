@@ -0,0 +1,78 @@
+package golexoffice_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hostwithquantum/golexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfile(t *testing.T) {
+	server := profileMock()
+	defer server.Close()
+
+	lexOffice := golexoffice.NewConfig("token", nil)
+	lexOffice.SetBaseUrl(server.URL)
+
+	t.Run("profile", func(t *testing.T) {
+		profile, err := lexOffice.Profile()
+		assert.NoError(t, err)
+		assert.Equal(t, "aa93e8a8-2aa3-470b-b914-caad8a255dd8", profile.OrganizationId)
+		assert.Equal(t, "Testfirma GmbH", profile.CompanyName)
+		assert.Equal(t, "3dea098b-fc0c-4b8a-9b1f-37e1d5bd0f1b", profile.ConnectionId)
+		assert.Equal(t, "net", profile.TaxType)
+		assert.False(t, profile.SmallBusiness)
+	})
+
+	t.Run("ping", func(t *testing.T) {
+		assert.NoError(t, lexOffice.Ping())
+
+		invalid := golexoffice.NewConfig("invalid", nil)
+		invalid.SetBaseUrl(server.URL)
+		assert.Error(t, invalid.Ping())
+	})
+
+	t.Run("verify organization", func(t *testing.T) {
+		assert.NoError(t, lexOffice.VerifyOrganization("aa93e8a8-2aa3-470b-b914-caad8a255dd8"))
+		assert.ErrorContains(t, lexOffice.VerifyOrganization("67c8c57b-6d07-4bdd-b579-55240d3c2df5"), "Testfirma GmbH")
+	})
+}
+
+func profileMock() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			//nolint:errcheck
+			w.Write([]byte(`{"status": 401, "error": "Unauthorized", "message": "Unauthorized"}`))
+			return
+		}
+
+		if r.Method == http.MethodGet && r.URL.Path == "/v1/profile" {
+			w.WriteHeader(http.StatusOK)
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"organizationId": "aa93e8a8-2aa3-470b-b914-caad8a255dd8",
+				"companyName": "Testfirma GmbH",
+				"created": {
+					"userId": "1aea4a49-0a8c-45c2-a9b3-b2eac1dd8f80",
+					"userName": "Frau Erika Musterfrau",
+					"userEmail": "erika.musterfrau@testfirma.de",
+					"date": "2017-01-03T13:15:45.000+01:00"
+				},
+				"connectionId": "3dea098b-fc0c-4b8a-9b1f-37e1d5bd0f1b",
+				"features": ["cashbox"],
+				"businessFeatures": ["INVOICING", "BOOKKEEPING"],
+				"subscriptionStatus": "active",
+				"taxType": "net",
+				"smallBusiness": false
+			}`))
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+	}))
+}
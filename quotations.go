@@ -0,0 +1,110 @@
+package golexoffice
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// QuotationBody is to define body data
+//
+// To turn an accepted quotation into an invoice, set its id as
+// PrecedingSalesVoucherId on the InvoiceBody for AddInvoice.
+type QuotationBody struct {
+	Id                string                        `json:"id,omitempty"`
+	OrganizationId    string                        `json:"organizationId,omitempty"`
+	CreatedDate       string                        `json:"createdDate,omitempty"`
+	UpdatedDate       string                        `json:"updatedDate,omitempty"`
+	Version           int                           `json:"version,omitempty"`
+	Archived          bool                          `json:"archived,omitempty"`
+	VoucherStatus     string                        `json:"voucherStatus,omitempty"`
+	VoucherNumber     string                        `json:"voucherNumber,omitempty"`
+	VoucherDate       string                        `json:"voucherDate"`
+	ExpirationDate    string                        `json:"expirationDate"`
+	Address           InvoiceBodyAddress            `json:"address"`
+	LineItems         []InvoiceBodyLineItems        `json:"lineItems"`
+	TotalPrice        InvoiceBodyTotalPrice         `json:"totalPrice"`
+	TaxAmounts        []InvoiceBodyTaxAmounts       `json:"taxAmounts,omitempty"`
+	TaxConditions     InvoiceBodyTaxConditions      `json:"taxConditions"`
+	PaymentConditions *InvoiceBodyPaymentConditions `json:"paymentConditions,omitempty"`
+	Title             string                        `json:"title,omitempty"`
+	Introduction      string                        `json:"introduction,omitempty"`
+	Remark            string                        `json:"remark,omitempty"`
+	Language          string                        `json:"language,omitempty"`
+	PrintLayoutId     string                        `json:"printLayoutId,omitempty"`
+	Files             *DocumentFile                 `json:"files,omitempty"`
+}
+
+// QuotationReturn is to decode json data
+type QuotationReturn struct {
+	Id          string `json:"id"`
+	ResourceUri string `json:"resourceUri"`
+	CreatedDate string `json:"createdDate"`
+	UpdatedDate string `json:"updatedDate"`
+	Version     int    `json:"version"`
+}
+
+// Quotation is to get a quotation by id
+func (c *Config) Quotation(id string) (QuotationBody, error) {
+
+	// Send request
+	response, err := c.Send("/v1/quotations/"+id, nil, "GET", "application/json")
+	if err != nil {
+		return QuotationBody{}, err
+	}
+
+	// Close request
+	defer response.Body.Close()
+
+	// Decode data
+	var decode QuotationBody
+
+	err = json.NewDecoder(response.Body).Decode(&decode)
+	if err != nil {
+		return QuotationBody{}, err
+	}
+
+	// Return data
+	return decode, nil
+
+}
+
+// CreateQuotation is to create a quotation
+//
+// Like with AddInvoice, a VoucherStatus of "open" finalizes the quotation.
+func (c *Config) CreateQuotation(body QuotationBody) (QuotationReturn, error) {
+
+	query := salesVoucherQuery(body.VoucherStatus == "open", "")
+	body.VoucherStatus = "" // unset for the request
+
+	// Convert body
+	convert, err := json.Marshal(body)
+	if err != nil {
+		return QuotationReturn{}, err
+	}
+
+	// Send request
+	response, err := c.Send("/v1/quotations"+query, bytes.NewBuffer(convert), "POST", "application/json")
+	if err != nil {
+		return QuotationReturn{}, err
+	}
+
+	// Close request
+	defer response.Body.Close()
+
+	// Decode data
+	var decode QuotationReturn
+
+	err = json.NewDecoder(response.Body).Decode(&decode)
+	if err != nil {
+		return QuotationReturn{}, err
+	}
+
+	// Return data
+	return decode, nil
+
+}
+
+// QuotationDocument is to render the pdf of a quotation
+func (c *Config) QuotationDocument(id string) (DocumentFile, error) {
+	return c.renderDocument("/v1/quotations/" + id)
+}
@@ -0,0 +1,112 @@
+package golexoffice_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hostwithquantum/golexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuotations(t *testing.T) {
+	server := quotationsMock()
+	defer server.Close()
+
+	lexOffice := golexoffice.NewConfig("token", nil)
+	lexOffice.SetBaseUrl(server.URL)
+
+	t.Run("create", func(t *testing.T) {
+		resp, err := lexOffice.CreateQuotation(golexoffice.QuotationBody{
+			VoucherStatus:  "open",
+			VoucherDate:    "2023-06-14T00:00:00.000+02:00",
+			ExpirationDate: "2023-07-14T00:00:00.000+02:00",
+			Address: golexoffice.InvoiceBodyAddress{
+				ContactId: "e9066f04-8cc7-4616-93f8-ac9ecc8479c8",
+			},
+			TotalPrice:    golexoffice.InvoiceBodyTotalPrice{Currency: "EUR"},
+			TaxConditions: golexoffice.InvoiceBodyTaxConditions{TaxType: "net"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "424f784e-1f4e-439e-8f71-19673e6d6583", resp.Id)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		quotation, err := lexOffice.Quotation("424f784e-1f4e-439e-8f71-19673e6d6583")
+		assert.NoError(t, err)
+		assert.Equal(t, "accepted", quotation.VoucherStatus)
+		assert.Equal(t, "AG0001", quotation.VoucherNumber)
+		assert.Equal(t, "2023-07-14T00:00:00.000+02:00", quotation.ExpirationDate)
+	})
+
+	t.Run("document", func(t *testing.T) {
+		document, err := lexOffice.QuotationDocument("424f784e-1f4e-439e-8f71-19673e6d6583")
+		assert.NoError(t, err)
+		assert.Equal(t, "b26e1d73-19ff-46b1-8929-09d8d73d9f3d", document.DocumentFileId)
+	})
+
+	t.Run("pursue invoice", func(t *testing.T) {
+		resp, err := lexOffice.AddInvoice(golexoffice.InvoiceBody{
+			PrecedingSalesVoucherId: "424f784e-1f4e-439e-8f71-19673e6d6583",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "0cf8142b-6f54-4c96-9766-6f44a9a4814b", resp.Id)
+	})
+}
+
+func quotationsMock() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/quotations":
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["expirationDate"] == "" ||
+				r.URL.Query().Get("finalize") != "true" {
+				w.WriteHeader(http.StatusNotAcceptable)
+				//nolint:errcheck
+				w.Write([]byte(`{"status": 406, "error": "Not Acceptable", "message": "unexpected request"}`))
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "424f784e-1f4e-439e-8f71-19673e6d6583",
+				"resourceUri": "https://api.lexoffice.io/v1/quotations/424f784e-1f4e-439e-8f71-19673e6d6583",
+				"createdDate": "2023-06-14T10:15:12.123+02:00",
+				"updatedDate": "2023-06-14T10:15:12.123+02:00",
+				"version": 1
+			}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/quotations/424f784e-1f4e-439e-8f71-19673e6d6583":
+			w.WriteHeader(http.StatusOK)
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "424f784e-1f4e-439e-8f71-19673e6d6583",
+				"version": 3,
+				"voucherStatus": "accepted",
+				"voucherNumber": "AG0001",
+				"voucherDate": "2023-06-14T00:00:00.000+02:00",
+				"expirationDate": "2023-07-14T00:00:00.000+02:00",
+				"address": {"contactId": "e9066f04-8cc7-4616-93f8-ac9ecc8479c8"},
+				"lineItems": [],
+				"totalPrice": {"currency": "EUR"},
+				"taxConditions": {"taxType": "net"}
+			}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/quotations/424f784e-1f4e-439e-8f71-19673e6d6583/document":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"documentFileId": "b26e1d73-19ff-46b1-8929-09d8d73d9f3d"}`)) //nolint:errcheck
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/invoices" &&
+			r.URL.Query().Get("precedingSalesVoucherId") == "424f784e-1f4e-439e-8f71-19673e6d6583":
+			w.WriteHeader(http.StatusCreated)
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "0cf8142b-6f54-4c96-9766-6f44a9a4814b",
+				"resourceUri": "https://api.lexoffice.io/v1/invoices/0cf8142b-6f54-4c96-9766-6f44a9a4814b",
+				"version": 1
+			}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
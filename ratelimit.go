@@ -0,0 +1,70 @@
+package golexoffice
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// defaultRequestInterval is the interval between the requests of a Config
+// with an EventResolver, lexoffice allows max 2 requests per second
+const defaultRequestInterval = 500 * time.Millisecond
+
+// rateLimiter is to space requests, so all requests of a token stay within
+// the rate limit together
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	isSet    bool
+	next     time.Time
+}
+
+// set is to set the interval between two requests
+func (r *rateLimiter) set(interval time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.interval = interval
+	r.isSet = true
+}
+
+// setDefault is to set the interval, unless it was set before
+func (r *rateLimiter) setDefault(interval time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.isSet {
+		r.interval = interval
+		r.isSet = true
+	}
+}
+
+// wait is to wait for the next request interval
+func (r *rateLimiter) wait(ctx context.Context) error {
+	r.mu.Lock()
+	if r.interval <= 0 {
+		r.mu.Unlock()
+		return nil
+	}
+	now := time.Now()
+	wait := r.next.Sub(now)
+	if wait < 0 {
+		wait = 0
+	}
+	r.next = now.Add(wait + r.interval)
+	r.mu.Unlock()
+
+	if wait == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,60 @@
+package golexoffice_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hostwithquantum/golexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestInterval(t *testing.T) {
+	var mu sync.Mutex
+	var requests []time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, time.Now())
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"countryCode": "DE"}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	lexOffice := golexoffice.NewConfig("token", nil)
+	lexOffice.SetBaseUrl(server.URL)
+	lexOffice.SetRequestInterval(500 * time.Millisecond)
+
+	t.Run("shared", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				response, err := lexOffice.Send("/v1/profile", nil, "GET", "application/json")
+				assert.NoError(t, err)
+				response.Body.Close()
+			}()
+		}
+		wg.Wait()
+
+		assert.Len(t, requests, 3)
+		assert.GreaterOrEqual(t, requests[2].Sub(requests[0]), 950*time.Millisecond)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		lexOffice.SetRequestInterval(0)
+		requests = nil
+
+		start := time.Now()
+		for i := 0; i < 3; i++ {
+			response, err := lexOffice.Send("/v1/profile", nil, "GET", "application/json")
+			assert.NoError(t, err)
+			response.Body.Close()
+		}
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+	})
+}
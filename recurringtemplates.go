@@ -0,0 +1,86 @@
+package golexoffice
+
+import (
+	"encoding/json"
+	"net/url"
+)
+
+// ExecutionInterval is the frequency of a recurring template
+type ExecutionInterval string
+
+const (
+	ExecutionIntervalWeekly     ExecutionInterval = "WEEKLY"
+	ExecutionIntervalBiweekly   ExecutionInterval = "BIWEEKLY"
+	ExecutionIntervalMonthly    ExecutionInterval = "MONTHLY"
+	ExecutionIntervalQuarterly  ExecutionInterval = "QUARTERLY"
+	ExecutionIntervalBiannually ExecutionInterval = "BIANNUALLY"
+	ExecutionIntervalAnnually   ExecutionInterval = "ANNUALLY"
+)
+
+// RecurringTemplateReturn is to decode json data, recurring templates are
+// read-only in the API
+//
+// The list of recurring templates does not contain the line items, get the
+// template by id for the full invoice content.
+type RecurringTemplateReturn struct {
+	Id                        string                          `json:"id"`
+	OrganizationId            string                          `json:"organizationId"`
+	CreatedDate               string                          `json:"createdDate"`
+	UpdatedDate               string                          `json:"updatedDate"`
+	Version                   int                             `json:"version"`
+	Address                   InvoiceBodyAddress              `json:"address"`
+	LineItems                 []InvoiceBodyLineItems          `json:"lineItems"`
+	TotalPrice                InvoiceBodyTotalPrice           `json:"totalPrice"`
+	TaxAmounts                []InvoiceBodyTaxAmounts         `json:"taxAmounts"`
+	TaxConditions             InvoiceBodyTaxConditions        `json:"taxConditions"`
+	PaymentConditions         *InvoiceBodyPaymentConditions   `json:"paymentConditions"`
+	ShippingConditions        InvoiceBodyShippingConditions   `json:"shippingConditions"`
+	Title                     string                          `json:"title"`
+	Introduction              string                          `json:"introduction"`
+	Remark                    string                          `json:"remark"`
+	Language                  string                          `json:"language"`
+	RecurringTemplateSettings RecurringTemplateReturnSettings `json:"recurringTemplateSettings"`
+}
+
+type RecurringTemplateReturnSettings struct {
+	Id                        string            `json:"id"`
+	StartDate                 string            `json:"startDate"`
+	EndDate                   string            `json:"endDate"`
+	Finalize                  bool              `json:"finalize"`
+	ShippingType              string            `json:"shippingType"`
+	ExecutionInterval         ExecutionInterval `json:"executionInterval"`
+	NextExecutionDate         string            `json:"nextExecutionDate"`
+	LastExecutionFailed       bool              `json:"lastExecutionFailed"`
+	LastExecutionErrorMessage string            `json:"lastExecutionErrorMessage"`
+	ExecutionStatus           string            `json:"executionStatus"`
+}
+
+// RecurringTemplates is to iterate over all recurring templates
+func (c *Config) RecurringTemplates() *Pager[RecurringTemplateReturn] {
+	return newPager[RecurringTemplateReturn](c, "/v1/recurring-templates", url.Values{})
+}
+
+// RecurringTemplate is to get a recurring template by id
+func (c *Config) RecurringTemplate(id string) (RecurringTemplateReturn, error) {
+
+	// Send request
+	response, err := c.Send("/v1/recurring-templates/"+id, nil, "GET", "application/json")
+	if err != nil {
+		return RecurringTemplateReturn{}, err
+	}
+
+	// Close request
+	defer response.Body.Close()
+
+	// Decode data
+	var decode RecurringTemplateReturn
+
+	err = json.NewDecoder(response.Body).Decode(&decode)
+	if err != nil {
+		return RecurringTemplateReturn{}, err
+	}
+
+	// Return data
+	return decode, nil
+
+}
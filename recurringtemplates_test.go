@@ -0,0 +1,97 @@
+package golexoffice_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hostwithquantum/golexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecurringTemplates(t *testing.T) {
+	server := recurringTemplatesMock()
+	defer server.Close()
+
+	lexOffice := golexoffice.NewConfig("token", nil)
+	lexOffice.SetBaseUrl(server.URL)
+
+	t.Run("list", func(t *testing.T) {
+		templates, err := lexOffice.RecurringTemplates().All()
+		assert.NoError(t, err)
+		assert.Len(t, templates, 2)
+		assert.Equal(t, golexoffice.ExecutionIntervalMonthly, templates[0].RecurringTemplateSettings.ExecutionInterval)
+		assert.Equal(t, golexoffice.ExecutionIntervalAnnually, templates[1].RecurringTemplateSettings.ExecutionInterval)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		template, err := lexOffice.RecurringTemplate("ac1d66a8-6d59-408b-9413-d56b1db7946f")
+		assert.NoError(t, err)
+		assert.Equal(t, "2023-07-01", template.RecurringTemplateSettings.NextExecutionDate)
+		assert.Len(t, template.LineItems, 1)
+		assert.Equal(t, "Hosting", template.LineItems[0].Name)
+	})
+}
+
+func recurringTemplatesMock() *httptest.Server {
+	pages := []string{
+		`{
+			"content": [{
+				"id": "ac1d66a8-6d59-408b-9413-d56b1db7946f",
+				"title": "Hosting",
+				"recurringTemplateSettings": {"executionInterval": "MONTHLY", "nextExecutionDate": "2023-07-01", "executionStatus": "ACTIVE"}
+			}],
+			"first": true, "last": false, "totalPages": 2, "totalElements": 2, "numberOfElements": 1, "size": 1, "number": 0
+		}`,
+		`{
+			"content": [{
+				"id": "2f6b5a44-1c3e-4b47-9a0d-2f8e1c9d7b6a",
+				"title": "Domains",
+				"recurringTemplateSettings": {"executionInterval": "ANNUALLY", "nextExecutionDate": "2024-01-01", "executionStatus": "ACTIVE"}
+			}],
+			"first": false, "last": true, "totalPages": 2, "totalElements": 2, "numberOfElements": 1, "size": 1, "number": 1
+		}`,
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v1/recurring-templates":
+			page := r.URL.Query().Get("page")
+			if page != "0" && page != "1" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			if page == "0" {
+				w.Write([]byte(pages[0])) //nolint:errcheck
+			} else {
+				w.Write([]byte(pages[1])) //nolint:errcheck
+			}
+		case "/v1/recurring-templates/ac1d66a8-6d59-408b-9413-d56b1db7946f":
+			w.WriteHeader(http.StatusOK)
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "ac1d66a8-6d59-408b-9413-d56b1db7946f",
+				"address": {"contactId": "e9066f04-8cc7-4616-93f8-ac9ecc8479c8"},
+				"lineItems": [{"type": "custom", "name": "Hosting", "quantity": 1, "unitPrice": {"currency": "EUR", "netAmount": 49, "taxRatePercentage": 19}}],
+				"totalPrice": {"currency": "EUR", "totalNetAmount": 49, "totalGrossAmount": 58.31, "totalTaxAmount": 9.31},
+				"taxConditions": {"taxType": "net"},
+				"title": "Hosting",
+				"recurringTemplateSettings": {
+					"id": "9c5b8e8e-4b9a-4a3a-8d4c-1a2b3c4d5e6f",
+					"startDate": "2023-01-01",
+					"finalize": true,
+					"shippingType": "service",
+					"executionInterval": "MONTHLY",
+					"nextExecutionDate": "2023-07-01",
+					"lastExecutionFailed": false,
+					"executionStatus": "ACTIVE"
+				}
+			}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
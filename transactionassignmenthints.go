@@ -0,0 +1,51 @@
+package golexoffice
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// TransactionAssignmentHintBody is to define body data, the external
+// reference is the reference of the bank transaction (e.g. its purpose)
+type TransactionAssignmentHintBody struct {
+	VoucherId         string `json:"voucherId"`
+	ExternalReference string `json:"externalReference"`
+}
+
+// TransactionAssignmentHintReturn is to decode json data
+type TransactionAssignmentHintReturn struct {
+	VoucherId         string `json:"voucherId"`
+	ExternalReference string `json:"externalReference"`
+}
+
+// AddTransactionAssignmentHint is to tell lexoffice which voucher a bank
+// transaction with the external reference belongs to
+func (c *Config) AddTransactionAssignmentHint(body TransactionAssignmentHintBody) (TransactionAssignmentHintReturn, error) {
+
+	// Convert body
+	convert, err := json.Marshal(body)
+	if err != nil {
+		return TransactionAssignmentHintReturn{}, err
+	}
+
+	// Send request
+	response, err := c.Send("/v1/transaction-assignment-hint", bytes.NewBuffer(convert), "POST", "application/json")
+	if err != nil {
+		return TransactionAssignmentHintReturn{}, err
+	}
+
+	// Close request
+	defer response.Body.Close()
+
+	// Decode data
+	var decode TransactionAssignmentHintReturn
+
+	err = json.NewDecoder(response.Body).Decode(&decode)
+	if err != nil {
+		return TransactionAssignmentHintReturn{}, err
+	}
+
+	// Return data
+	return decode, nil
+
+}
@@ -0,0 +1,59 @@
+package golexoffice_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hostwithquantum/golexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransactionAssignmentHint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/transaction-assignment-hint" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		var body golexoffice.TransactionAssignmentHintBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.VoucherId == "" {
+			w.WriteHeader(http.StatusNotAcceptable)
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"status": 406,
+				"error": "Not Acceptable",
+				"path": "/v1/transaction-assignment-hint",
+				"message": "Validation failed for request. Please see details list for specific causes.",
+				"details": [{"violation": "NOTNULL", "field": "voucherId", "message": "darf nicht leer sein"}]
+			}`))
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		//nolint:errcheck
+		json.NewEncoder(w).Encode(body)
+	}))
+	defer server.Close()
+
+	lexOffice := golexoffice.NewConfig("token", nil)
+	lexOffice.SetBaseUrl(server.URL)
+
+	t.Run("create", func(t *testing.T) {
+		hint, err := lexOffice.AddTransactionAssignmentHint(golexoffice.TransactionAssignmentHintBody{
+			VoucherId:         "0cf8142b-6f54-4c96-9766-6f44a9a4814b",
+			ExternalReference: "RE1234",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "0cf8142b-6f54-4c96-9766-6f44a9a4814b", hint.VoucherId)
+		assert.Equal(t, "RE1234", hint.ExternalReference)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		_, err := lexOffice.AddTransactionAssignmentHint(golexoffice.TransactionAssignmentHintBody{ExternalReference: "RE1234"})
+		assert.ErrorContains(t, err, "field: voucherId (NOTNULL): darf nicht leer sein")
+	})
+}
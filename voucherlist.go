@@ -0,0 +1,104 @@
+package golexoffice
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// VoucherlistFilter is to filter the voucherlist, empty values are not sent
+//
+// VoucherTypes and VoucherStatuses are required by lexoffice, they default to
+// VoucherTypeAny and VoucherStatusAny.
+type VoucherlistFilter struct {
+	VoucherTypes    []VoucherType
+	VoucherStatuses []VoucherStatus
+	Archived        *bool
+	ContactId       string
+	VoucherDateFrom time.Time
+	VoucherDateTo   time.Time
+	CreatedDateFrom time.Time
+	CreatedDateTo   time.Time
+	UpdatedDateFrom time.Time
+	UpdatedDateTo   time.Time
+	VoucherNumber   string
+	Size            int
+	Sort            string
+}
+
+// VoucherlistReturnContent is to decode json data
+type VoucherlistReturnContent struct {
+	Id            string        `json:"id"`
+	VoucherType   VoucherType   `json:"voucherType"`
+	VoucherStatus VoucherStatus `json:"voucherStatus"`
+	VoucherNumber string        `json:"voucherNumber"`
+	VoucherDate   string        `json:"voucherDate"`
+	CreatedDate   string        `json:"createdDate"`
+	UpdatedDate   string        `json:"updatedDate"`
+	DueDate       string        `json:"dueDate"`
+	ContactId     string        `json:"contactId"`
+	ContactName   string        `json:"contactName"`
+	TotalAmount   float64       `json:"totalAmount"`
+	OpenAmount    float64       `json:"openAmount"`
+	Currency      string        `json:"currency"`
+	Archived      bool          `json:"archived"`
+}
+
+// Voucherlist is to iterate over all vouchers (invoices, credit notes, ...)
+// matching the filter
+func (c *Config) Voucherlist(filter VoucherlistFilter) *Pager[VoucherlistReturnContent] {
+	return newPager[VoucherlistReturnContent](c, "/v1/voucherlist", filter.query())
+}
+
+func (f VoucherlistFilter) query() url.Values {
+	query := url.Values{}
+
+	types := make([]string, 0, len(f.VoucherTypes))
+	for _, voucherType := range f.VoucherTypes {
+		types = append(types, string(voucherType))
+	}
+	if len(types) == 0 {
+		types = append(types, string(VoucherTypeAny))
+	}
+	query.Set("voucherType", strings.Join(types, ","))
+
+	statuses := make([]string, 0, len(f.VoucherStatuses))
+	for _, voucherStatus := range f.VoucherStatuses {
+		statuses = append(statuses, string(voucherStatus))
+	}
+	if len(statuses) == 0 {
+		statuses = append(statuses, string(VoucherStatusAny))
+	}
+	query.Set("voucherStatus", strings.Join(statuses, ","))
+
+	if f.Archived != nil {
+		query.Set("archived", strconv.FormatBool(*f.Archived))
+	}
+	if f.ContactId != "" {
+		query.Set("contactId", f.ContactId)
+	}
+	if f.VoucherNumber != "" {
+		query.Set("voucherNumber", f.VoucherNumber)
+	}
+	if f.Size > 0 {
+		query.Set("size", strconv.Itoa(f.Size))
+	}
+	if f.Sort != "" {
+		query.Set("sort", f.Sort)
+	}
+
+	setDate := func(key string, date time.Time) {
+		if !date.IsZero() {
+			query.Set(key, date.Format("2006-01-02"))
+		}
+	}
+	setDate("voucherDateFrom", f.VoucherDateFrom)
+	setDate("voucherDateTo", f.VoucherDateTo)
+	setDate("createdDateFrom", f.CreatedDateFrom)
+	setDate("createdDateTo", f.CreatedDateTo)
+	setDate("updatedDateFrom", f.UpdatedDateFrom)
+	setDate("updatedDateTo", f.UpdatedDateTo)
+
+	return query
+}
@@ -0,0 +1,92 @@
+package golexoffice_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hostwithquantum/golexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVoucherlist(t *testing.T) {
+	server := voucherlistMock()
+	defer server.Close()
+
+	lexOffice := golexoffice.NewConfig("token", nil)
+	lexOffice.SetBaseUrl(server.URL)
+
+	t.Run("all pages", func(t *testing.T) {
+		archived := false
+		pager := lexOffice.Voucherlist(golexoffice.VoucherlistFilter{
+			VoucherTypes:    []golexoffice.VoucherType{golexoffice.VoucherTypeInvoice},
+			VoucherStatuses: []golexoffice.VoucherStatus{golexoffice.VoucherStatusOverdue},
+			Archived:        &archived,
+			VoucherDateFrom: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		})
+
+		var numbers []string
+		for pager.Next() {
+			assert.Equal(t, golexoffice.VoucherStatusOverdue, pager.Value().VoucherStatus)
+			numbers = append(numbers, pager.Value().VoucherNumber)
+		}
+		assert.NoError(t, pager.Err())
+		assert.Equal(t, []string{"RE1001", "RE1002", "RE1003"}, numbers)
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		vouchers, err := lexOffice.Voucherlist(golexoffice.VoucherlistFilter{}).All()
+		assert.Error(t, err)
+		assert.ErrorContains(t, err, "voucherStatus must be overdue")
+		assert.Empty(t, vouchers)
+	})
+}
+
+func voucherlistMock() *httptest.Server {
+	pages := []string{
+		`{
+			"content": [
+				{"id": "1", "voucherType": "invoice", "voucherStatus": "overdue", "voucherNumber": "RE1001", "totalAmount": 119.0, "openAmount": 119.0, "currency": "EUR"},
+				{"id": "2", "voucherType": "invoice", "voucherStatus": "overdue", "voucherNumber": "RE1002", "totalAmount": 238.0, "openAmount": 100.0, "currency": "EUR"}
+			],
+			"first": true, "last": false, "totalPages": 2, "totalElements": 3, "numberOfElements": 2, "size": 2, "number": 0
+		}`,
+		`{
+			"content": [
+				{"id": "3", "voucherType": "invoice", "voucherStatus": "overdue", "voucherNumber": "RE1003", "totalAmount": 59.5, "openAmount": 59.5, "currency": "EUR"}
+			],
+			"first": false, "last": true, "totalPages": 2, "totalElements": 3, "numberOfElements": 1, "size": 2, "number": 1
+		}`,
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		query := r.URL.Query()
+		if r.URL.Path != "/v1/voucherlist" || query.Get("voucherType") != "invoice" ||
+			query.Get("voucherStatus") != "overdue" || query.Get("archived") != "false" ||
+			query.Get("voucherDateFrom") != "2023-01-01" {
+			w.WriteHeader(http.StatusBadRequest)
+			//nolint:errcheck
+			w.Write([]byte(fmt.Sprintf(`{
+				"status": 400,
+				"error": "Bad Request",
+				"path": "%s",
+				"message": "voucherStatus must be overdue"
+			}`, r.URL.Path)))
+			return
+		}
+
+		var page int
+		fmt.Sscan(query.Get("page"), &page) //nolint:errcheck
+		if page >= len(pages) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(pages[page])) //nolint:errcheck
+	}))
+}
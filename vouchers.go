@@ -0,0 +1,157 @@
+package golexoffice
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// VoucherType is the type of a voucher
+type VoucherType string
+
+const (
+	VoucherTypeSalesInvoice       VoucherType = "salesinvoice"
+	VoucherTypeSalesCreditNote    VoucherType = "salescreditnote"
+	VoucherTypePurchaseInvoice    VoucherType = "purchaseinvoice"
+	VoucherTypePurchaseCreditNote VoucherType = "purchasecreditnote"
+
+	// sales vouchers, these are only returned by the voucherlist
+	VoucherTypeInvoice            VoucherType = "invoice"
+	VoucherTypeDownPaymentInvoice VoucherType = "downpaymentinvoice"
+	VoucherTypeCreditNote         VoucherType = "creditnote"
+	VoucherTypeOrderConfirmation  VoucherType = "orderconfirmation"
+	VoucherTypeQuotation          VoucherType = "quotation"
+	VoucherTypeDeliveryNote       VoucherType = "deliverynote"
+	VoucherTypeAny                VoucherType = "any"
+)
+
+// VoucherStatus is the status of a voucher
+type VoucherStatus string
+
+const (
+	VoucherStatusOpen        VoucherStatus = "open"
+	VoucherStatusPaid        VoucherStatus = "paid"
+	VoucherStatusPaidOff     VoucherStatus = "paidoff"
+	VoucherStatusVoided      VoucherStatus = "voided"
+	VoucherStatusTransferred VoucherStatus = "transferred"
+	VoucherStatusSepaDebit   VoucherStatus = "sepadebit"
+	VoucherStatusUnchecked   VoucherStatus = "unchecked"
+
+	// sales voucher states, these are only returned by the voucherlist
+	VoucherStatusDraft    VoucherStatus = "draft"
+	VoucherStatusOverdue  VoucherStatus = "overdue"
+	VoucherStatusAccepted VoucherStatus = "accepted"
+	VoucherStatusRejected VoucherStatus = "rejected"
+	VoucherStatusAny      VoucherStatus = "any"
+)
+
+// VoucherBody is to define a bookkeeping voucher (e.g. a supplier receipt)
+//
+// Set UseCollectiveContact when there is no ContactId, lexoffice books the
+// voucher on the collective contact then.
+type VoucherBody struct {
+	Id                   string             `json:"id,omitempty"`
+	OrganizationId       string             `json:"organizationId,omitempty"`
+	Type                 VoucherType        `json:"type"`
+	VoucherStatus        VoucherStatus      `json:"voucherStatus,omitempty"`
+	VoucherNumber        string             `json:"voucherNumber"`
+	VoucherDate          string             `json:"voucherDate"`
+	ShippingDate         string             `json:"shippingDate,omitempty"`
+	DueDate              string             `json:"dueDate,omitempty"`
+	TotalGrossAmount     float64            `json:"totalGrossAmount"`
+	TotalTaxAmount       float64            `json:"totalTaxAmount"`
+	TaxType              string             `json:"taxType"`
+	UseCollectiveContact bool               `json:"useCollectiveContact"`
+	ContactId            string             `json:"contactId,omitempty"`
+	Remark               string             `json:"remark,omitempty"`
+	VoucherItems         []VoucherBodyItems `json:"voucherItems"`
+	Files                []string           `json:"files,omitempty"`
+	CreatedDate          string             `json:"createdDate,omitempty"`
+	UpdatedDate          string             `json:"updatedDate,omitempty"`
+	Version              int                `json:"version"`
+}
+
+type VoucherBodyItems struct {
+	Amount         float64 `json:"amount"`
+	TaxAmount      float64 `json:"taxAmount"`
+	TaxRatePercent float64 `json:"taxRatePercent"`
+	CategoryId     string  `json:"categoryId"`
+}
+
+// VoucherReturn is to decode json data
+type VoucherReturn struct {
+	Id          string `json:"id"`
+	ResourceUri string `json:"resourceUri"`
+	CreatedDate string `json:"createdDate"`
+	UpdatedDate string `json:"updatedDate"`
+	Version     int    `json:"version"`
+}
+
+// GetVoucher is to get a voucher by id
+func (c *Config) GetVoucher(id string) (VoucherBody, error) {
+
+	// Send request
+	response, err := c.Send("/v1/vouchers/"+id, nil, "GET", "application/json")
+	if err != nil {
+		return VoucherBody{}, err
+	}
+
+	// Close request
+	defer response.Body.Close()
+
+	// Decode data
+	var decode VoucherBody
+
+	err = json.NewDecoder(response.Body).Decode(&decode)
+	if err != nil {
+		return VoucherBody{}, err
+	}
+
+	// Return data
+	return decode, nil
+
+}
+
+// CreateVoucher is to create a voucher
+func (c *Config) CreateVoucher(body VoucherBody) (VoucherReturn, error) {
+	return c.sendVoucher("/v1/vouchers", "POST", body)
+}
+
+// UpdateVoucher is to update a voucher, the body needs the id & the version
+func (c *Config) UpdateVoucher(body VoucherBody) (VoucherReturn, error) {
+	return c.sendVoucher("/v1/vouchers/"+body.Id, "PUT", body)
+}
+
+// AttachFileToVoucher is to upload a file (e.g. the receipt) to a voucher
+func (c *Config) AttachFileToVoucher(id string, upload FileUpload) (FileUploadReturn, error) {
+	return c.uploadFile("/v1/vouchers/"+id+"/files", upload)
+}
+
+func (c *Config) sendVoucher(path, method string, body VoucherBody) (VoucherReturn, error) {
+
+	// Convert body
+	convert, err := json.Marshal(body)
+	if err != nil {
+		return VoucherReturn{}, err
+	}
+
+	// Send request
+	response, err := c.Send(path, bytes.NewBuffer(convert), method, "application/json")
+	if err != nil {
+		return VoucherReturn{}, err
+	}
+
+	// Close request
+	defer response.Body.Close()
+
+	// Decode data
+	var decode VoucherReturn
+
+	err = json.NewDecoder(response.Body).Decode(&decode)
+	if err != nil {
+		return VoucherReturn{}, err
+	}
+
+	// Return data
+	return decode, nil
+
+}
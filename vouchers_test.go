@@ -0,0 +1,124 @@
+package golexoffice_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hostwithquantum/golexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVouchers(t *testing.T) {
+	server := vouchersMock()
+	defer server.Close()
+
+	lexOffice := golexoffice.NewConfig("token", nil)
+	lexOffice.SetBaseUrl(server.URL)
+
+	t.Run("create", func(t *testing.T) {
+		resp, err := lexOffice.CreateVoucher(golexoffice.VoucherBody{
+			Type:                 golexoffice.VoucherTypePurchaseInvoice,
+			VoucherNumber:        "RE-4711",
+			VoucherDate:          "2023-06-14",
+			TotalGrossAmount:     119,
+			TotalTaxAmount:       19,
+			TaxType:              "gross",
+			UseCollectiveContact: true,
+			VoucherItems: []golexoffice.VoucherBodyItems{{
+				Amount:         119,
+				TaxAmount:      19,
+				TaxRatePercent: 19,
+				CategoryId:     "16d04a28-1c6c-4a2f-b1e8-7e0f5a4b1b6a",
+			}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "e9cb8e0b-9a4f-4b93-8e9c-4e5b0c8e7b3a", resp.Id)
+		assert.Equal(t, 1, resp.Version)
+	})
+
+	t.Run("create=invalid", func(t *testing.T) {
+		_, err := lexOffice.CreateVoucher(golexoffice.VoucherBody{})
+		assert.ErrorContains(t, err, "key: missing_entity (voucherItems): validation_failure")
+	})
+
+	t.Run("get", func(t *testing.T) {
+		voucher, err := lexOffice.GetVoucher("e9cb8e0b-9a4f-4b93-8e9c-4e5b0c8e7b3a")
+		assert.NoError(t, err)
+		assert.Equal(t, golexoffice.VoucherStatusOpen, voucher.VoucherStatus)
+		assert.Equal(t, 119.0, voucher.TotalGrossAmount)
+		assert.Len(t, voucher.VoucherItems, 1)
+		assert.Equal(t, []string{"8118c402-1234-4a1b-b1f5-1ab7e8a0e4a4"}, voucher.Files)
+	})
+
+	t.Run("attach file", func(t *testing.T) {
+		resp, err := lexOffice.AttachFileToVoucher("e9cb8e0b-9a4f-4b93-8e9c-4e5b0c8e7b3a", golexoffice.FileUpload{
+			Reader:   strings.NewReader("%PDF-1.4"),
+			FileName: "RE-4711.pdf",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "8118c402-1234-4a1b-b1f5-1ab7e8a0e4a4", resp.Id)
+	})
+}
+
+func vouchersMock() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/vouchers":
+			var body golexoffice.VoucherBody
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil || len(body.VoucherItems) == 0 {
+				w.WriteHeader(http.StatusBadRequest)
+				//nolint:errcheck
+				w.Write([]byte(`{
+					"requestId":"75d4dad6-6ccb-40fd-8c22-797f2d421d98",
+					"IssueList":[
+						{"i18nKey":"missing_entity","source":"voucherItems","type":"validation_failure"}
+					]
+				}`))
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "e9cb8e0b-9a4f-4b93-8e9c-4e5b0c8e7b3a",
+				"resourceUri": "https://api.lexoffice.io/v1/vouchers/e9cb8e0b-9a4f-4b93-8e9c-4e5b0c8e7b3a",
+				"createdDate": "2023-06-14T10:15:12.123+02:00",
+				"updatedDate": "2023-06-14T10:15:12.123+02:00",
+				"version": 1
+			}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/vouchers/e9cb8e0b-9a4f-4b93-8e9c-4e5b0c8e7b3a":
+			w.WriteHeader(http.StatusOK)
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "e9cb8e0b-9a4f-4b93-8e9c-4e5b0c8e7b3a",
+				"organizationId": "aa93e8a8-2aa3-470b-b914-caad8a255dd8",
+				"type": "purchaseinvoice",
+				"voucherStatus": "open",
+				"voucherNumber": "RE-4711",
+				"voucherDate": "2023-06-14T00:00:00.000+02:00",
+				"totalGrossAmount": 119.00,
+				"totalTaxAmount": 19.00,
+				"taxType": "gross",
+				"useCollectiveContact": true,
+				"voucherItems": [
+					{"amount": 119.00, "taxAmount": 19.00, "taxRatePercent": 19, "categoryId": "16d04a28-1c6c-4a2f-b1e8-7e0f5a4b1b6a"}
+				],
+				"files": ["8118c402-1234-4a1b-b1f5-1ab7e8a0e4a4"],
+				"version": 2
+			}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/vouchers/e9cb8e0b-9a4f-4b93-8e9c-4e5b0c8e7b3a/files":
+			if _, _, err := r.FormFile("file"); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"id": "8118c402-1234-4a1b-b1f5-1ab7e8a0e4a4"}`)) //nolint:errcheck
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
@@ -0,0 +1,242 @@
+package golexoffice
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha512"
+	"crypto/x509"
+	_ "embed"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// WebhookSignatureHeader contains the signature of a webhook callback
+	WebhookSignatureHeader = "X-Lxo-Signature"
+
+	// webhookMaxBodySize limits the body of a webhook callback, the payload
+	// only contains a few ids
+	webhookMaxBodySize = 64 * 1024
+
+	// defaultWebhookMaxAge covers the redeliveries of lexoffice, after a
+	// callback failed
+	defaultWebhookMaxAge = 24 * time.Hour
+)
+
+// lexofficePublicKey is the public key lexoffice signs webhook callbacks with
+//
+//go:embed lexoffice_webhook_public_key.pem
+var lexofficePublicKey []byte
+
+// WebhookEvent is to decode the payload of a webhook callback
+type WebhookEvent struct {
+	OrganizationId string    `json:"organizationId"`
+	EventType      EventType `json:"eventType"`
+	ResourceId     string    `json:"resourceId"`
+	EventDate      string    `json:"eventDate"`
+}
+
+// WebhookHandlerFunc is called for each verified event, an error results in
+// a 500 response, so lexoffice delivers the event again (see MaxAge)
+type WebhookHandlerFunc func(ctx context.Context, event WebhookEvent) error
+
+// WebhookHandler is a http.Handler to receive webhook callbacks
+//
+// Each callback is verified against the public key lexoffice publishes in
+// its documentation (https://developers.lexoffice.io/docs/#event-subscriptions-endpoint-webhook-signature)
+// and dispatched to the callbacks registered with On. Events older than
+// MaxAge and events which were handled before are rejected.
+type WebhookHandler struct {
+	// MaxAge is the maximum age of an event (default: 24 hours)
+	//
+	// Redeliveries of failed events keep their event date, so MaxAge has to
+	// cover the time lexoffice delivers an event again, otherwise it is
+	// rejected for good. The handled events are remembered for MaxAge to
+	// detect replays, so a longer MaxAge needs more memory.
+	MaxAge time.Duration
+
+	publicKey *rsa.PublicKey
+	handlers  map[EventType]WebhookHandlerFunc
+
+	mu   sync.Mutex
+	seen map[string]time.Time
+}
+
+// NewWebhookHandler is to create a handler which verifies callbacks with the
+// public key of lexoffice, embedded from lexoffice_webhook_public_key.pem
+func NewWebhookHandler() (*WebhookHandler, error) {
+	publicKey, err := parseWebhookPublicKey(lexofficePublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("webhook: embedded lexoffice public key: %w", err)
+	}
+
+	return NewWebhookHandlerWithKey(publicKey)
+}
+
+// NewWebhookHandlerWithKey is to create a handler which verifies callbacks
+// with another public key (e.g. in tests), see ParseWebhookPublicKey
+func NewWebhookHandlerWithKey(publicKey *rsa.PublicKey) (*WebhookHandler, error) {
+	if publicKey == nil {
+		return nil, errors.New("webhook: public key is required")
+	}
+
+	return &WebhookHandler{
+		MaxAge:    defaultWebhookMaxAge,
+		publicKey: publicKey,
+		handlers:  map[EventType]WebhookHandlerFunc{},
+		seen:      map[string]time.Time{},
+	}, nil
+}
+
+// ParseWebhookPublicKey is to parse the PEM encoded public key of lexoffice
+func ParseWebhookPublicKey(data []byte) (*rsa.PublicKey, error) {
+	publicKey, err := parseWebhookPublicKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("webhook: %w", err)
+	}
+
+	return publicKey, nil
+}
+
+func parseWebhookPublicKey(data []byte) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found in public key")
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing public key: %w", err)
+	}
+
+	publicKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("public key is not a RSA key (%T)", key)
+	}
+
+	return publicKey, nil
+}
+
+// On is to register the callback for an event type, events without a
+// callback are acknowledged and dropped
+func (h *WebhookHandler) On(eventType EventType, fn WebhookHandlerFunc) {
+	h.handlers[eventType] = fn
+}
+
+// ServeHTTP is to receive a webhook callback
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, webhookMaxBodySize+1))
+	if err != nil {
+		http.Error(w, "unable to read body", http.StatusBadRequest)
+		return
+	}
+	if len(body) > webhookMaxBodySize {
+		http.Error(w, "body is too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	signature := r.Header.Get(WebhookSignatureHeader)
+	err = h.verify(body, signature)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	var event WebhookEvent
+	err = json.Unmarshal(body, &event)
+	if err != nil {
+		http.Error(w, "unable to decode event", http.StatusBadRequest)
+		return
+	}
+
+	eventDate, err := time.Parse(time.RFC3339, event.EventDate)
+	if err != nil {
+		http.Error(w, "unable to parse event date", http.StatusBadRequest)
+		return
+	}
+	if time.Since(eventDate) > h.MaxAge {
+		http.Error(w, "event is too old", http.StatusBadRequest)
+		return
+	}
+
+	if !h.reserve(signature) {
+		http.Error(w, "event was handled before", http.StatusConflict)
+		return
+	}
+
+	fn, ok := h.handlers[event.EventType]
+	if ok {
+		err = fn(r.Context(), event)
+		if err != nil {
+			h.release(signature)
+			http.Error(w, "unable to handle event", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// verify is to check the (base64 encoded) SHA512withRSA signature of the body
+func (h *WebhookHandler) verify(body []byte, signature string) error {
+	if h.publicKey == nil {
+		return errors.New("missing public key")
+	}
+	if signature == "" {
+		return errors.New("missing signature")
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return errors.New("malformed signature")
+	}
+
+	hashed := sha512.Sum512(body)
+	err = rsa.VerifyPKCS1v15(h.publicKey, crypto.SHA512, hashed[:], decoded)
+	if err != nil {
+		return errors.New("invalid signature")
+	}
+
+	return nil
+}
+
+// reserve is to remember an event until it is too old anyway, it returns
+// false when the event was handled (or is handled) already
+func (h *WebhookHandler) reserve(signature string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for key, seen := range h.seen {
+		if time.Since(seen) > h.MaxAge {
+			delete(h.seen, key)
+		}
+	}
+
+	if _, ok := h.seen[signature]; ok {
+		return false
+	}
+	h.seen[signature] = time.Now()
+
+	return true
+}
+
+// release is to forget an event which failed, so it can be delivered again
+func (h *WebhookHandler) release(signature string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.seen, signature)
+}
@@ -0,0 +1,154 @@
+package golexoffice_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hostwithquantum/golexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookHandler(t *testing.T) {
+	privateKey, publicKey := webhookKeys(t)
+
+	var received []golexoffice.WebhookEvent
+	handler, err := golexoffice.NewWebhookHandlerWithKey(publicKey)
+	assert.NoError(t, err)
+	handler.On(golexoffice.EventTypeInvoiceStatusChanged, func(_ context.Context, event golexoffice.WebhookEvent) error {
+		received = append(received, event)
+		return nil
+	})
+	handler.On(golexoffice.EventTypePaymentChanged, func(_ context.Context, _ golexoffice.WebhookEvent) error {
+		return errors.New("database is down")
+	})
+
+	failures := 1
+	handler.On(golexoffice.EventTypeVoucherChanged, func(_ context.Context, _ golexoffice.WebhookEvent) error {
+		if failures > 0 {
+			failures--
+			return errors.New("database is down")
+		}
+		return nil
+	})
+
+	invoiceId := "0cf8142b-6f54-4c96-9766-6f44a9a4814b"
+
+	t.Run("dispatch", func(t *testing.T) {
+		body := webhookEvent(golexoffice.EventTypeInvoiceStatusChanged, invoiceId, time.Now())
+		assert.Equal(t, http.StatusOK, sendWebhook(handler, body, signWebhook(t, privateKey, body)))
+		assert.Len(t, received, 1)
+		assert.Equal(t, invoiceId, received[0].ResourceId)
+
+		// the same event again
+		assert.Equal(t, http.StatusConflict, sendWebhook(handler, body, signWebhook(t, privateKey, body)))
+		assert.Len(t, received, 1)
+	})
+
+	t.Run("no callback", func(t *testing.T) {
+		body := webhookEvent(golexoffice.EventTypeContactChanged, invoiceId, time.Now())
+		assert.Equal(t, http.StatusOK, sendWebhook(handler, body, signWebhook(t, privateKey, body)))
+	})
+
+	t.Run("callback fails", func(t *testing.T) {
+		body := webhookEvent(golexoffice.EventTypePaymentChanged, invoiceId, time.Now())
+		signature := signWebhook(t, privateKey, body)
+		assert.Equal(t, http.StatusInternalServerError, sendWebhook(handler, body, signature))
+		// lexoffice delivers again, which is not a replay
+		assert.Equal(t, http.StatusInternalServerError, sendWebhook(handler, body, signature))
+	})
+
+	t.Run("late retry", func(t *testing.T) {
+		// lexoffice delivers the failed event again an hour later, with the
+		// original event date
+		body := webhookEvent(golexoffice.EventTypeVoucherChanged, invoiceId, time.Now().Add(-time.Hour))
+		signature := signWebhook(t, privateKey, body)
+		assert.Equal(t, http.StatusInternalServerError, sendWebhook(handler, body, signature))
+		assert.Equal(t, http.StatusOK, sendWebhook(handler, body, signature))
+		assert.Equal(t, http.StatusConflict, sendWebhook(handler, body, signature))
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		body := webhookEvent(golexoffice.EventTypeInvoiceStatusChanged, invoiceId, time.Now())
+		assert.Equal(t, http.StatusUnauthorized, sendWebhook(handler, body, ""))
+		assert.Equal(t, http.StatusUnauthorized, sendWebhook(handler, body, "not base64"))
+		assert.Equal(t, http.StatusUnauthorized, sendWebhook(handler, body, signWebhook(t, privateKey, body+" ")))
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		body := webhookEvent(golexoffice.EventTypeInvoiceStatusChanged, invoiceId, time.Now())
+		body += strings.Repeat(" ", 64*1024)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, sendWebhook(handler, body, signWebhook(t, privateKey, body)))
+	})
+
+	t.Run("too old", func(t *testing.T) {
+		body := webhookEvent(golexoffice.EventTypeInvoiceStatusChanged, invoiceId, time.Now().Add(-25*time.Hour))
+		assert.Equal(t, http.StatusBadRequest, sendWebhook(handler, body, signWebhook(t, privateKey, body)))
+	})
+}
+
+func TestNewWebhookHandler(t *testing.T) {
+	handler, err := golexoffice.NewWebhookHandler()
+	assert.NoError(t, err, "lexoffice_webhook_public_key.pem needs the public key from the lexoffice documentation")
+	assert.NotNil(t, handler)
+}
+
+func TestNewWebhookHandlerWithKey(t *testing.T) {
+	_, err := golexoffice.NewWebhookHandlerWithKey(nil)
+	assert.EqualError(t, err, "webhook: public key is required")
+
+	_, err = golexoffice.ParseWebhookPublicKey([]byte("no key"))
+	assert.EqualError(t, err, "webhook: no PEM block found in public key")
+
+	// a zero handler rejects callbacks instead of panicking
+	assert.Equal(t, http.StatusUnauthorized, sendWebhook(&golexoffice.WebhookHandler{}, "{}", "c2lnbmF0dXJl"))
+}
+
+func webhookKeys(t *testing.T) (*rsa.PrivateKey, *rsa.PublicKey) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	encoded, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	assert.NoError(t, err)
+
+	publicKey, err := golexoffice.ParseWebhookPublicKey(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: encoded}))
+	assert.NoError(t, err)
+
+	return privateKey, publicKey
+}
+
+func signWebhook(t *testing.T, privateKey *rsa.PrivateKey, body string) string {
+	hashed := sha512.Sum512([]byte(body))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, privateKey, crypto.SHA512, hashed[:])
+	assert.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(signature)
+}
+
+func webhookEvent(eventType golexoffice.EventType, resourceId string, eventDate time.Time) string {
+	return fmt.Sprintf(`{
+		"organizationId": "aa93e8a8-2aa3-470b-b914-caad8a255dd8",
+		"eventType": "%s",
+		"resourceId": "%s",
+		"eventDate": "%s"
+	}`, eventType, resourceId, eventDate.Format("2006-01-02T15:04:05.000Z07:00"))
+}
+
+func sendWebhook(handler http.Handler, body, signature string) int {
+	request := httptest.NewRequest(http.MethodPost, "/webhooks/lexoffice", strings.NewReader(body))
+	request.Header.Set(golexoffice.WebhookSignatureHeader, signature)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder.Code
+}
@@ -0,0 +1,136 @@
+package golexoffice
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// leitwegIdPattern is the format of a Leitweg-ID: the coarse addressing, an
+// optional fine addressing and the check digits
+var leitwegIdPattern = regexp.MustCompile(`^[0-9]{2,12}(-[0-9A-Za-z]{1,30})?-[0-9]{2}$`)
+
+// InvoiceBodyXRechnung is to define the XRechnung data of an invoice, the
+// buyer reference of public authorities is their Leitweg-ID
+type InvoiceBodyXRechnung struct {
+	BuyerReference         string `json:"buyerReference,omitempty"`
+	VendorNumberAtCustomer string `json:"vendorNumberAtCustomer,omitempty"`
+}
+
+// InvoiceXRechnung is to use the XRechnung data of a contact on an invoice,
+// it is nil when the contact has none
+func (c ContactsReturnContent) InvoiceXRechnung() *InvoiceBodyXRechnung {
+	if c.XRechnung == nil || c.XRechnung.BuyerReference == "" {
+		return nil
+	}
+
+	return &InvoiceBodyXRechnung{
+		BuyerReference:         c.XRechnung.BuyerReference,
+		VendorNumberAtCustomer: c.XRechnung.VendorNumberAtCustomer,
+	}
+}
+
+// ValidLeitwegId is to check the format and the check digits (ISO 7064,
+// MOD 97-10) of a Leitweg-ID
+func ValidLeitwegId(id string) bool {
+	if !leitwegIdPattern.MatchString(id) {
+		return false
+	}
+
+	var digits strings.Builder
+	for _, r := range strings.ToUpper(strings.ReplaceAll(id, "-", "")) {
+		if r >= 'A' && r <= 'Z' {
+			digits.WriteString(strconv.Itoa(int(r-'A') + 10))
+		} else {
+			digits.WriteRune(r)
+		}
+	}
+
+	number, ok := new(big.Int).SetString(digits.String(), 10)
+	if !ok {
+		return false
+	}
+
+	return new(big.Int).Mod(number, big.NewInt(97)).Int64() == 1
+}
+
+// ValidateXRechnung is to check the fields an XRechnung requires before the
+// invoice is created with AddInvoice, all missing fields are returned
+//
+// Buyer references in the format of a Leitweg-ID must have valid check digits.
+// The address is either a contact or needs a name, zip, city and country.
+func (i InvoiceBody) ValidateXRechnung() error {
+	var keep []error
+	missing := func(field string) {
+		keep = append(keep, fmt.Errorf("xRechnung: %s is required", field))
+	}
+
+	switch {
+	case i.XRechnung == nil || i.XRechnung.BuyerReference == "":
+		missing("xRechnung.buyerReference")
+	case leitwegIdPattern.MatchString(i.XRechnung.BuyerReference) && !ValidLeitwegId(i.XRechnung.BuyerReference):
+		keep = append(keep, fmt.Errorf("xRechnung: xRechnung.buyerReference is no valid Leitweg-ID: %s", i.XRechnung.BuyerReference))
+	}
+
+	if i.VoucherDate == "" {
+		missing("voucherDate")
+	}
+
+	if i.Address.ContactId == "" {
+		for _, field := range [][2]string{
+			{"address.name", i.Address.Name},
+			{"address.zip", i.Address.Zip},
+			{"address.city", i.Address.City},
+			{"address.countryCode", i.Address.CountryCode},
+		} {
+			if field[1] == "" {
+				missing(field[0])
+			}
+		}
+	}
+
+	if len(i.LineItems) == 0 {
+		missing("lineItems")
+	}
+	for index, item := range i.LineItems {
+		if item.Type == "text" {
+			continue
+		}
+		prefix := "lineItems[" + strconv.Itoa(index) + "]."
+		if item.Name == "" {
+			missing(prefix + "name")
+		}
+		if toFloat(item.Quantity) == 0 {
+			missing(prefix + "quantity")
+		}
+		if item.UnitName == "" {
+			missing(prefix + "unitName")
+		}
+	}
+
+	if i.TotalPrice.Currency == "" {
+		missing("totalPrice.currency")
+	}
+	if i.TaxConditions.TaxType == "" {
+		missing("taxConditions.taxType")
+	}
+	if i.PaymentConditions == nil || i.PaymentConditions.PaymentTermLabel == "" {
+		missing("paymentConditions.paymentTermLabel")
+	}
+
+	return errors.Join(keep...)
+}
+
+// DownloadXRechnung is to download the XRechnung (xml) of a invoice, close
+// the download after reading it
+func (c *Config) DownloadXRechnung(invoiceId string) (*FileDownload, error) {
+	document, err := c.InvoiceDocument(invoiceId)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.DownloadFile(document.DocumentFileId, FileAcceptXML)
+}
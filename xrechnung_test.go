@@ -0,0 +1,101 @@
+package golexoffice_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hostwithquantum/golexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidLeitwegId(t *testing.T) {
+	assert.True(t, golexoffice.ValidLeitwegId("04011000-1234512345-06"))
+	assert.True(t, golexoffice.ValidLeitwegId("991-33333TEST-33"))
+	assert.True(t, golexoffice.ValidLeitwegId("04011000-12345-03"))
+
+	assert.False(t, golexoffice.ValidLeitwegId("04011000-1234512345-07"))
+	assert.False(t, golexoffice.ValidLeitwegId("04011000"))
+	assert.False(t, golexoffice.ValidLeitwegId("Einkauf 4711"))
+}
+
+func TestValidateXRechnung(t *testing.T) {
+	contact := golexoffice.ContactsReturnContent{
+		Id:        "e9066f04-8cc7-4616-93f8-ac9ecc8479c8",
+		XRechnung: &golexoffice.ContactBodyXRechnung{BuyerReference: "04011000-1234512345-06", VendorNumberAtCustomer: "70123456"},
+	}
+
+	invoice := golexoffice.InvoiceBody{
+		VoucherDate: "2023-02-22T00:00:00.000+01:00",
+		Address:     golexoffice.InvoiceBodyAddress{ContactId: contact.Id},
+		LineItems: []golexoffice.InvoiceBodyLineItems{
+			{Type: "custom", Name: "Consulting", Quantity: 8, UnitName: "Stunde"},
+			{Type: "text", Name: "Leistungszeitraum Februar"},
+		},
+		TotalPrice:        golexoffice.InvoiceBodyTotalPrice{Currency: "EUR"},
+		TaxConditions:     golexoffice.InvoiceBodyTaxConditions{TaxType: "net"},
+		PaymentConditions: &golexoffice.InvoiceBodyPaymentConditions{PaymentTermLabel: "30 Tage netto", PaymentTermDuration: 30},
+		XRechnung:         contact.InvoiceXRechnung(),
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		assert.NoError(t, invoice.ValidateXRechnung())
+		assert.Equal(t, "70123456", invoice.XRechnung.VendorNumberAtCustomer)
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		err := golexoffice.InvoiceBody{
+			LineItems: []golexoffice.InvoiceBodyLineItems{{Type: "custom", Name: "Consulting"}},
+		}.ValidateXRechnung()
+		assert.ErrorContains(t, err, "xRechnung: xRechnung.buyerReference is required")
+		assert.ErrorContains(t, err, "xRechnung: address.city is required")
+		assert.ErrorContains(t, err, "xRechnung: lineItems[0].quantity is required")
+		assert.ErrorContains(t, err, "xRechnung: lineItems[0].unitName is required")
+		assert.ErrorContains(t, err, "xRechnung: paymentConditions.paymentTermLabel is required")
+
+		assert.Nil(t, golexoffice.ContactsReturnContent{}.InvoiceXRechnung())
+	})
+
+	t.Run("invalid leitweg-id", func(t *testing.T) {
+		wrong := invoice
+		wrong.XRechnung = &golexoffice.InvoiceBodyXRechnung{BuyerReference: "04011000-1234512345-07"}
+		assert.ErrorContains(t, wrong.ValidateXRechnung(), "no valid Leitweg-ID")
+
+		// buyer references of companies are free text
+		wrong.XRechnung = &golexoffice.InvoiceBodyXRechnung{BuyerReference: "Einkauf 4711"}
+		assert.NoError(t, wrong.ValidateXRechnung())
+	})
+}
+
+func TestDownloadXRechnung(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/v1/invoices/0cf8142b-6f54-4c96-9766-6f44a9a4814b/document":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"documentFileId": "4ff9b8e4-8e30-4c5b-8d4a-5b4bce6f0a4e"}`)) //nolint:errcheck
+		case r.URL.Path == "/v1/files/4ff9b8e4-8e30-4c5b-8d4a-5b4bce6f0a4e" && r.Header.Get("Accept") == golexoffice.FileAcceptXML:
+			w.Header().Set("Content-Type", "application/xml")
+			w.Header().Set("Content-Disposition", `attachment; filename="RE1001.xml"`)
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`<?xml version="1.0"?><rsm:CrossIndustryInvoice/>`)) //nolint:errcheck
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	lexOffice := golexoffice.NewConfig("token", nil)
+	lexOffice.SetBaseUrl(server.URL)
+
+	file, err := lexOffice.DownloadXRechnung("0cf8142b-6f54-4c96-9766-6f44a9a4814b")
+	assert.NoError(t, err)
+	defer file.Close()
+
+	assert.Equal(t, "RE1001.xml", file.FileName)
+
+	content, err := io.ReadAll(file)
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "CrossIndustryInvoice")
+}